@@ -0,0 +1,240 @@
+// Command problemctl syncs problem packages with the problems service.
+//
+//	problemctl export   -id 5 -o ./problems/two-sum
+//	problemctl import   -company 3 ./problems/two-sum
+//	problemctl import   -company 3 -id 5 two-sum.zip
+//	problemctl validate ./problems/two-sum
+//
+// Export writes a directory, or a zip archive when the output ends in .zip.
+// Import creates a new problem, or replaces problem -id and all of its test
+// cases. Reference solutions are run against the test cases through the
+// executor before anything is saved unless -no-verify is given.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/problempkg"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+)
+
+const defaultMaxPackageBytes = 32 << 20
+
+// errInvalidPackage is returned after the per-file errors have been printed.
+var errInvalidPackage = errors.New("invalid problem package")
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:])
+	case "validate":
+		err = runValidate(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "problemctl: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "problemctl:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: problemctl <command> [flags]
+
+commands:
+  export    write a problem to a package directory or .zip
+  import    create or replace a problem from a package
+  validate  check a package without contacting any service
+
+Run "problemctl <command> -h" for the flags of a command.`)
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:50051", "problems service address")
+	id := fs.Int("id", 0, "problem ID to export")
+	out := fs.String("o", "", "output directory, or archive if it ends in .zip (default problem-<id>.zip)")
+	timeout := fs.Duration("timeout", 30*time.Second, "request timeout")
+	_ = fs.Parse(args)
+
+	if *id <= 0 {
+		return errors.New("export: -id is required")
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("problem-%d.zip", *id)
+	}
+
+	client, err := problems.NewClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	pkg, err := problempkg.Export(ctx, client, int32(*id))
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(filepath.Ext(*out), ".zip") {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		if err := pkg.WriteZip(f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	} else if err := pkg.WriteDir(*out); err != nil {
+		return err
+	}
+
+	fmt.Printf("exported problem %d with %d test cases to %s\n", *id, len(pkg.TestCases), *out)
+	return nil
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:50051", "problems service address")
+	executorAddr := fs.String("executor", "", "executor service address (default -addr)")
+	companyID := fs.Int("company", 0, "ID of the company that owns the problem")
+	id := fs.Int("id", 0, "problem ID to replace; a new problem is created when unset")
+	noVerify := fs.Bool("no-verify", false, "skip running the reference solutions")
+	maxBytes := fs.Int64("max-bytes", defaultMaxPackageBytes, "maximum total size of the package files")
+	timeout := fs.Duration("timeout", 2*time.Minute, "overall timeout, including verification")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return errors.New("import: expected exactly one package path")
+	}
+	if *companyID <= 0 {
+		return errors.New("import: -company is required")
+	}
+
+	pkg, err := readPackage(fs.Arg(0), *maxBytes)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if !*noVerify {
+		if *executorAddr == "" {
+			*executorAddr = *addr
+		}
+		executorClient, err := executor.NewClient(*executorAddr)
+		if err != nil {
+			return err
+		}
+		defer executorClient.Close()
+
+		fileErrors, err := problempkg.VerifySolutions(ctx, testcases.NewVerifier(executorClient, *timeout), pkg)
+		if err != nil {
+			return err
+		}
+		if len(fileErrors) > 0 {
+			printFileErrors(fileErrors)
+			return errors.New("reference solutions do not produce the expected output")
+		}
+	}
+
+	client, err := problems.NewClient(*addr)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	problem, testCases, err := problempkg.Import(ctx, client, int32(*companyID), int32(*id), pkg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("imported problem %d (%q) with %d test cases\n", problem.Id, problem.Title, len(testCases))
+	return nil
+}
+
+func runValidate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	maxBytes := fs.Int64("max-bytes", defaultMaxPackageBytes, "maximum total size of the package files")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return errors.New("validate: expected exactly one package path")
+	}
+
+	pkg, err := readPackage(fs.Arg(0), *maxBytes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: ok, %d test cases, %d reference solutions, %d starter files, %d harnesses\n",
+		fs.Arg(0), len(pkg.TestCases), len(pkg.ReferenceSolutions), len(pkg.StarterCode), len(pkg.Harnesses))
+	return nil
+}
+
+// readPackage reads a package from a directory or a .zip file, printing any
+// validation errors.
+func readPackage(path string, maxBytes int64) (*problempkg.Package, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkg *problempkg.Package
+	var fileErrors []model.FileError
+	if info.IsDir() {
+		pkg, fileErrors = problempkg.Read(os.DirFS(path), maxBytes)
+	} else {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		pkg, fileErrors = problempkg.ReadZip(data, maxBytes)
+	}
+
+	if len(fileErrors) > 0 {
+		printFileErrors(fileErrors)
+		return nil, errInvalidPackage
+	}
+	return pkg, nil
+}
+
+func printFileErrors(fileErrors []model.FileError) {
+	for _, e := range fileErrors {
+		file := e.File
+		if file == "" {
+			file = "(package)"
+		}
+		fmt.Fprintf(os.Stderr, "%s: %s\n", file, e.Message)
+	}
+}
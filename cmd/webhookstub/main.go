@@ -0,0 +1,73 @@
+// Command webhookstub is a local webhook receiver for trying out job
+// callbacks and company webhook subscriptions. It verifies each delivery's
+// signature and prints it.
+//
+//	webhookstub -secret <company callback secret> -addr :9090
+//	webhookstub -secret <secret> -fail 2   # answer 500 to the first two requests
+//	webhookstub -secret <subscription secret>
+//
+// Job callbacks are signed with the company's callback secret, shown at
+// GET /api/v1/companies/webhooks/callback-secret.
+//
+// Point callback_url or the subscription URL at http://localhost:9090/ with
+// the gateway's webhooks.allow_private_networks enabled.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync/atomic"
+
+	"go-code-runner-microservice/api-gateway/internal/webhook"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "listen address")
+	secret := flag.String("secret", "", "company callback secret or subscription secret")
+	fail := flag.Int("fail", 0, "answer 500 to this many requests first, to exercise retries")
+	flag.Parse()
+
+	if *secret == "" {
+		log.Fatal("webhookstub: a signing secret is required")
+	}
+
+	var failures atomic.Int64
+	failures.Store(int64(*fail))
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		valid := webhook.Verify([]byte(*secret), r.Header.Get(webhook.TimestampHeader), body, r.Header.Get(webhook.SignatureHeader))
+		fmt.Printf("%s %s delivery=%s event=%s signature_valid=%t\n",
+			r.Method, r.URL.Path, r.Header.Get(webhook.DeliveryHeader), r.Header.Get(webhook.EventHeader), valid)
+
+		var pretty bytes.Buffer
+		if json.Indent(&pretty, body, "", "  ") == nil {
+			fmt.Println(pretty.String())
+		} else {
+			fmt.Println(string(body))
+		}
+
+		if !valid {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		if failures.Add(-1) >= 0 {
+			http.Error(w, "failing on purpose", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	log.Printf("webhookstub listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
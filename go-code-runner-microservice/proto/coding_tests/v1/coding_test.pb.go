@@ -230,9 +230,12 @@ type SubmitTestRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestId           string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
-	Code             string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	PassedPercentage int32  `protobuf:"varint,3,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
+	TestId string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	// Empty for multi-problem assessments, whose code is in their problem
+	// submissions.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// For multi-problem assessments, the weighted total of the problem scores.
+	PassedPercentage int32 `protobuf:"varint,3,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
 }
 
 func (x *SubmitTestRequest) Reset() {
@@ -335,19 +338,20 @@ func (x *SubmitTestResponse) GetMessage() string {
 	return ""
 }
 
-type GenerateTestRequest struct {
+type SubmitProblemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CompanyId      int32  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	ProblemId      int32  `protobuf:"varint,2,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
-	ExpiresInHours int32  `protobuf:"varint,3,opt,name=expires_in_hours,json=expiresInHours,proto3" json:"expires_in_hours,omitempty"`
-	ClientId       string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	TestId           string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	ProblemId        int32  `protobuf:"varint,2,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	Language         string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Code             string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	PassedPercentage int32  `protobuf:"varint,5,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
 }
 
-func (x *GenerateTestRequest) Reset() {
-	*x = GenerateTestRequest{}
+func (x *SubmitProblemRequest) Reset() {
+	*x = SubmitProblemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,13 +359,13 @@ func (x *GenerateTestRequest) Reset() {
 	}
 }
 
-func (x *GenerateTestRequest) String() string {
+func (x *SubmitProblemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTestRequest) ProtoMessage() {}
+func (*SubmitProblemRequest) ProtoMessage() {}
 
-func (x *GenerateTestRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmitProblemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,50 +377,56 @@ func (x *GenerateTestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTestRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitProblemRequest.ProtoReflect.Descriptor instead.
+func (*SubmitProblemRequest) Descriptor() ([]byte, []int) {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GenerateTestRequest) GetCompanyId() int32 {
+func (x *SubmitProblemRequest) GetTestId() string {
 	if x != nil {
-		return x.CompanyId
+		return x.TestId
 	}
-	return 0
+	return ""
 }
 
-func (x *GenerateTestRequest) GetProblemId() int32 {
+func (x *SubmitProblemRequest) GetProblemId() int32 {
 	if x != nil {
 		return x.ProblemId
 	}
 	return 0
 }
 
-func (x *GenerateTestRequest) GetExpiresInHours() int32 {
+func (x *SubmitProblemRequest) GetLanguage() string {
 	if x != nil {
-		return x.ExpiresInHours
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *GenerateTestRequest) GetClientId() string {
+func (x *SubmitProblemRequest) GetCode() string {
 	if x != nil {
-		return x.ClientId
+		return x.Code
 	}
 	return ""
 }
 
-type GenerateTestResponse struct {
+func (x *SubmitProblemRequest) GetPassedPercentage() int32 {
+	if x != nil {
+		return x.PassedPercentage
+	}
+	return 0
+}
+
+type SubmitProblemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Test *CodingTest `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
-	Link string      `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Submission *ProblemSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 }
 
-func (x *GenerateTestResponse) Reset() {
-	*x = GenerateTestResponse{}
+func (x *SubmitProblemResponse) Reset() {
+	*x = SubmitProblemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,13 +434,13 @@ func (x *GenerateTestResponse) Reset() {
 	}
 }
 
-func (x *GenerateTestResponse) String() string {
+func (x *SubmitProblemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTestResponse) ProtoMessage() {}
+func (*SubmitProblemResponse) ProtoMessage() {}
 
-func (x *GenerateTestResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmitProblemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,35 +452,31 @@ func (x *GenerateTestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTestResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitProblemResponse.ProtoReflect.Descriptor instead.
+func (*SubmitProblemResponse) Descriptor() ([]byte, []int) {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GenerateTestResponse) GetTest() *CodingTest {
+func (x *SubmitProblemResponse) GetSubmission() *ProblemSubmission {
 	if x != nil {
-		return x.Test
+		return x.Submission
 	}
 	return nil
 }
 
-func (x *GenerateTestResponse) GetLink() string {
-	if x != nil {
-		return x.Link
-	}
-	return ""
-}
-
-type GetCompanyTestsRequest struct {
+type AutoSubmitTestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CompanyId int32 `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	TestId string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	// Last code the candidate worked on; empty when there is none.
+	Code             string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	PassedPercentage int32  `protobuf:"varint,3,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
 }
 
-func (x *GetCompanyTestsRequest) Reset() {
-	*x = GetCompanyTestsRequest{}
+func (x *AutoSubmitTestRequest) Reset() {
+	*x = AutoSubmitTestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,13 +484,13 @@ func (x *GetCompanyTestsRequest) Reset() {
 	}
 }
 
-func (x *GetCompanyTestsRequest) String() string {
+func (x *AutoSubmitTestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyTestsRequest) ProtoMessage() {}
+func (*AutoSubmitTestRequest) ProtoMessage() {}
 
-func (x *GetCompanyTestsRequest) ProtoReflect() protoreflect.Message {
+func (x *AutoSubmitTestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,28 +502,42 @@ func (x *GetCompanyTestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyTestsRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyTestsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AutoSubmitTestRequest.ProtoReflect.Descriptor instead.
+func (*AutoSubmitTestRequest) Descriptor() ([]byte, []int) {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCompanyTestsRequest) GetCompanyId() int32 {
+func (x *AutoSubmitTestRequest) GetTestId() string {
 	if x != nil {
-		return x.CompanyId
+		return x.TestId
+	}
+	return ""
+}
+
+func (x *AutoSubmitTestRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AutoSubmitTestRequest) GetPassedPercentage() int32 {
+	if x != nil {
+		return x.PassedPercentage
 	}
 	return 0
 }
 
-type GetCompanyTestsResponse struct {
+type AutoSubmitTestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tests []*CodingTest `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetCompanyTestsResponse) Reset() {
-	*x = GetCompanyTestsResponse{}
+func (x *AutoSubmitTestResponse) Reset() {
+	*x = AutoSubmitTestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,13 +545,13 @@ func (x *GetCompanyTestsResponse) Reset() {
 	}
 }
 
-func (x *GetCompanyTestsResponse) String() string {
+func (x *AutoSubmitTestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyTestsResponse) ProtoMessage() {}
+func (*AutoSubmitTestResponse) ProtoMessage() {}
 
-func (x *GetCompanyTestsResponse) ProtoReflect() protoreflect.Message {
+func (x *AutoSubmitTestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,41 +563,37 @@ func (x *GetCompanyTestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyTestsResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyTestsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AutoSubmitTestResponse.ProtoReflect.Descriptor instead.
+func (*AutoSubmitTestResponse) Descriptor() ([]byte, []int) {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCompanyTestsResponse) GetTests() []*CodingTest {
+func (x *AutoSubmitTestResponse) GetMessage() string {
 	if x != nil {
-		return x.Tests
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type CodingTest struct {
+type Draft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CompanyId           int32                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	ProblemId           int32                  `protobuf:"varint,3,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
-	CandidateName       string                 `protobuf:"bytes,4,opt,name=candidate_name,json=candidateName,proto3" json:"candidate_name,omitempty"`
-	CandidateEmail      string                 `protobuf:"bytes,5,opt,name=candidate_email,json=candidateEmail,proto3" json:"candidate_email,omitempty"`
-	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	StartedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	TestDurationMinutes int32                  `protobuf:"varint,10,opt,name=test_duration_minutes,json=testDurationMinutes,proto3" json:"test_duration_minutes,omitempty"`
-	SubmissionCode      string                 `protobuf:"bytes,11,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
-	PassedPercentage    int32                  `protobuf:"varint,12,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Versions start at 1 and increase with every save.
+	Version  int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// Execution mode, "program" or "harness".
+	Mode    string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Code    string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	SavedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	// Problem of a multi-problem assessment the draft is for; drafts of each
+	// problem are versioned separately.
+	ProblemId int32 `protobuf:"varint,6,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
 }
 
-func (x *CodingTest) Reset() {
-	*x = CodingTest{}
+func (x *Draft) Reset() {
+	*x = Draft{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,13 +601,13 @@ func (x *CodingTest) Reset() {
 	}
 }
 
-func (x *CodingTest) String() string {
+func (x *Draft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CodingTest) ProtoMessage() {}
+func (*Draft) ProtoMessage() {}
 
-func (x *CodingTest) ProtoReflect() protoreflect.Message {
+func (x *Draft) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,109 +619,1827 @@ func (x *CodingTest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CodingTest.ProtoReflect.Descriptor instead.
-func (*CodingTest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CodingTest) GetId() string {
+func (x *Draft) GetVersion() int32 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *CodingTest) GetCompanyId() int32 {
+func (x *Draft) GetLanguage() string {
 	if x != nil {
-		return x.CompanyId
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *CodingTest) GetProblemId() int32 {
+func (x *Draft) GetMode() string {
 	if x != nil {
-		return x.ProblemId
+		return x.Mode
 	}
-	return 0
+	return ""
 }
 
-func (x *CodingTest) GetCandidateName() string {
+func (x *Draft) GetCode() string {
 	if x != nil {
-		return x.CandidateName
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CodingTest) GetCandidateEmail() string {
+func (x *Draft) GetSavedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CandidateEmail
+		return x.SavedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CodingTest) GetStatus() string {
+func (x *Draft) GetProblemId() int32 {
 	if x != nil {
-		return x.Status
+		return x.ProblemId
 	}
-	return ""
+	return 0
 }
 
-func (x *CodingTest) GetStartedAt() *timestamppb.Timestamp {
+type DraftVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	SavedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+}
+
+func (x *DraftVersion) Reset() {
+	*x = DraftVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftVersion) ProtoMessage() {}
+
+func (x *DraftVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftVersion.ProtoReflect.Descriptor instead.
+func (*DraftVersion) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DraftVersion) GetVersion() int32 {
 	if x != nil {
-		return x.StartedAt
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *CodingTest) GetCompletedAt() *timestamppb.Timestamp {
+func (x *DraftVersion) GetSavedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CompletedAt
+		return x.SavedAt
 	}
 	return nil
 }
 
-func (x *CodingTest) GetExpiresAt() *timestamppb.Timestamp {
+type SaveDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestId    string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	Language  string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Mode      string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Code      string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	ProblemId int32  `protobuf:"varint,5,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+}
+
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftRequest) ProtoMessage() {}
+
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SaveDraftRequest) GetTestId() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.TestId
 	}
-	return nil
+	return ""
 }
 
-func (x *CodingTest) GetTestDurationMinutes() int32 {
+func (x *SaveDraftRequest) GetLanguage() string {
 	if x != nil {
-		return x.TestDurationMinutes
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *CodingTest) GetSubmissionCode() string {
+func (x *SaveDraftRequest) GetMode() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *CodingTest) GetPassedPercentage() int32 {
+func (x *SaveDraftRequest) GetCode() string {
 	if x != nil {
-		return x.PassedPercentage
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *CodingTest) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SaveDraftRequest) GetProblemId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ProblemId
 	}
-	return nil
+	return 0
 }
 
-func (x *CodingTest) GetUpdatedAt() *timestamppb.Timestamp {
+type SaveDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft *Draft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *SaveDraftResponse) Reset() {
+	*x = SaveDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftResponse) ProtoMessage() {}
+
+func (x *SaveDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveDraftResponse) GetDraft() *Draft {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Draft
 	}
 	return nil
 }
 
+type GetDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestId string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	// Version to return; zero returns the latest.
+	Version   int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ProblemId int32 `protobuf:"varint,3,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+}
+
+func (x *GetDraftRequest) Reset() {
+	*x = GetDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftRequest) ProtoMessage() {}
+
+func (x *GetDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetDraftRequest) GetTestId() string {
+	if x != nil {
+		return x.TestId
+	}
+	return ""
+}
+
+func (x *GetDraftRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetDraftRequest) GetProblemId() int32 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+type GetDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft *Draft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	// Every saved version, oldest first.
+	Versions []*DraftVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *GetDraftResponse) Reset() {
+	*x = GetDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftResponse) ProtoMessage() {}
+
+func (x *GetDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDraftResponse) GetDraft() *Draft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *GetDraftResponse) GetVersions() []*DraftVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GenerateTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId      int32  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ProblemId      int32  `protobuf:"varint,2,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	ExpiresInHours int32  `protobuf:"varint,3,opt,name=expires_in_hours,json=expiresInHours,proto3" json:"expires_in_hours,omitempty"`
+	ClientId       string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Problem version to pin; zero pins the current version.
+	ProblemVersion int32 `protobuf:"varint,5,opt,name=problem_version,json=problemVersion,proto3" json:"problem_version,omitempty"`
+	// Problems of a multi-problem assessment; problem_id and problem_version
+	// are ignored when set.
+	Problems []*AssessmentProblem `protobuf:"bytes,6,rep,name=problems,proto3" json:"problems,omitempty"`
+	// Overall time limit; zero uses the service default.
+	TestDurationMinutes int32 `protobuf:"varint,7,opt,name=test_duration_minutes,json=testDurationMinutes,proto3" json:"test_duration_minutes,omitempty"`
+	// Template the settings were taken from, recorded on the test; zero when
+	// the test was generated from raw fields.
+	TemplateId int32 `protobuf:"varint,8,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Languages the candidate may use; empty allows every language.
+	AllowedLanguages []string `protobuf:"bytes,9,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	// Instructions shown to the candidate before the test starts.
+	Instructions string `protobuf:"bytes,10,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	// Score a candidate needs to pass, in percent; zero disables it.
+	PassingThreshold int32 `protobuf:"varint,11,opt,name=passing_threshold,json=passingThreshold,proto3" json:"passing_threshold,omitempty"`
+}
+
+func (x *GenerateTestRequest) Reset() {
+	*x = GenerateTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestRequest) ProtoMessage() {}
+
+func (x *GenerateTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GenerateTestRequest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetProblemId() int32 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetExpiresInHours() int32 {
+	if x != nil {
+		return x.ExpiresInHours
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GenerateTestRequest) GetProblemVersion() int32 {
+	if x != nil {
+		return x.ProblemVersion
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetProblems() []*AssessmentProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *GenerateTestRequest) GetTestDurationMinutes() int32 {
+	if x != nil {
+		return x.TestDurationMinutes
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *GenerateTestRequest) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
+}
+
+func (x *GenerateTestRequest) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *GenerateTestRequest) GetPassingThreshold() int32 {
+	if x != nil {
+		return x.PassingThreshold
+	}
+	return 0
+}
+
+type GenerateTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test *CodingTest `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	Link string      `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *GenerateTestResponse) Reset() {
+	*x = GenerateTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestResponse) ProtoMessage() {}
+
+func (x *GenerateTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateTestResponse) GetTest() *CodingTest {
+	if x != nil {
+		return x.Test
+	}
+	return nil
+}
+
+func (x *GenerateTestResponse) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type GetCompanyTestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId int32 `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *GetCompanyTestsRequest) Reset() {
+	*x = GetCompanyTestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyTestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyTestsRequest) ProtoMessage() {}
+
+func (x *GetCompanyTestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyTestsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyTestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCompanyTestsRequest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type GetCompanyTestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*CodingTest `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+}
+
+func (x *GetCompanyTestsResponse) Reset() {
+	*x = GetCompanyTestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyTestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyTestsResponse) ProtoMessage() {}
+
+func (x *GetCompanyTestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyTestsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyTestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCompanyTestsResponse) GetTests() []*CodingTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+type CodingTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId           int32                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ProblemId           int32                  `protobuf:"varint,3,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	CandidateName       string                 `protobuf:"bytes,4,opt,name=candidate_name,json=candidateName,proto3" json:"candidate_name,omitempty"`
+	CandidateEmail      string                 `protobuf:"bytes,5,opt,name=candidate_email,json=candidateEmail,proto3" json:"candidate_email,omitempty"`
+	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	TestDurationMinutes int32                  `protobuf:"varint,10,opt,name=test_duration_minutes,json=testDurationMinutes,proto3" json:"test_duration_minutes,omitempty"`
+	SubmissionCode      string                 `protobuf:"bytes,11,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	PassedPercentage    int32                  `protobuf:"varint,12,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Version of the problem the test was generated with; the candidate sees
+	// and is graded against this version even if the problem changes later.
+	ProblemVersion int32 `protobuf:"varint,15,opt,name=problem_version,json=problemVersion,proto3" json:"problem_version,omitempty"`
+	// Problems of a multi-problem assessment, in the order they are shown;
+	// empty for single-problem tests. problem_id is the first of them.
+	Problems []*AssessmentProblem `protobuf:"bytes,16,rep,name=problems,proto3" json:"problems,omitempty"`
+	// Latest submission for each problem of a multi-problem assessment.
+	Submissions      []*ProblemSubmission `protobuf:"bytes,17,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	TemplateId       int32                `protobuf:"varint,18,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	AllowedLanguages []string             `protobuf:"bytes,19,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	Instructions     string               `protobuf:"bytes,20,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	PassingThreshold int32                `protobuf:"varint,21,opt,name=passing_threshold,json=passingThreshold,proto3" json:"passing_threshold,omitempty"`
+}
+
+func (x *CodingTest) Reset() {
+	*x = CodingTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodingTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodingTest) ProtoMessage() {}
+
+func (x *CodingTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodingTest.ProtoReflect.Descriptor instead.
+func (*CodingTest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CodingTest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CodingTest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *CodingTest) GetProblemId() int32 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *CodingTest) GetCandidateName() string {
+	if x != nil {
+		return x.CandidateName
+	}
+	return ""
+}
+
+func (x *CodingTest) GetCandidateEmail() string {
+	if x != nil {
+		return x.CandidateEmail
+	}
+	return ""
+}
+
+func (x *CodingTest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CodingTest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *CodingTest) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CodingTest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CodingTest) GetTestDurationMinutes() int32 {
+	if x != nil {
+		return x.TestDurationMinutes
+	}
+	return 0
+}
+
+func (x *CodingTest) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
+	}
+	return ""
+}
+
+func (x *CodingTest) GetPassedPercentage() int32 {
+	if x != nil {
+		return x.PassedPercentage
+	}
+	return 0
+}
+
+func (x *CodingTest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CodingTest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CodingTest) GetProblemVersion() int32 {
+	if x != nil {
+		return x.ProblemVersion
+	}
+	return 0
+}
+
+func (x *CodingTest) GetProblems() []*AssessmentProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *CodingTest) GetSubmissions() []*ProblemSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *CodingTest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CodingTest) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
+}
+
+func (x *CodingTest) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *CodingTest) GetPassingThreshold() int32 {
+	if x != nil {
+		return x.PassingThreshold
+	}
+	return 0
+}
+
+type AssessmentProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProblemId int32 `protobuf:"varint,1,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	// Problem version to pin; zero pins the current version.
+	ProblemVersion int32 `protobuf:"varint,2,opt,name=problem_version,json=problemVersion,proto3" json:"problem_version,omitempty"`
+	// Relative weight of the problem in the total score.
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *AssessmentProblem) Reset() {
+	*x = AssessmentProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessmentProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentProblem) ProtoMessage() {}
+
+func (x *AssessmentProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentProblem.ProtoReflect.Descriptor instead.
+func (*AssessmentProblem) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AssessmentProblem) GetProblemId() int32 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *AssessmentProblem) GetProblemVersion() int32 {
+	if x != nil {
+		return x.ProblemVersion
+	}
+	return 0
+}
+
+func (x *AssessmentProblem) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type ProblemSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProblemId        int32                  `protobuf:"varint,1,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	Language         string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	PassedPercentage int32                  `protobuf:"varint,4,opt,name=passed_percentage,json=passedPercentage,proto3" json:"passed_percentage,omitempty"`
+	SubmittedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (x *ProblemSubmission) Reset() {
+	*x = ProblemSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProblemSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProblemSubmission) ProtoMessage() {}
+
+func (x *ProblemSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProblemSubmission.ProtoReflect.Descriptor instead.
+func (*ProblemSubmission) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProblemSubmission) GetProblemId() int32 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *ProblemSubmission) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ProblemSubmission) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProblemSubmission) GetPassedPercentage() int32 {
+	if x != nil {
+		return x.PassedPercentage
+	}
+	return 0
+}
+
+func (x *ProblemSubmission) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type AssessmentTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId int32 `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// Names are unique within a company.
+	Name                string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Problems            []*AssessmentProblem `protobuf:"bytes,4,rep,name=problems,proto3" json:"problems,omitempty"`
+	TestDurationMinutes int32                `protobuf:"varint,5,opt,name=test_duration_minutes,json=testDurationMinutes,proto3" json:"test_duration_minutes,omitempty"`
+	// Expiry of tests generated from the template, unless generate sets one.
+	ExpiresInHours   int32                  `protobuf:"varint,6,opt,name=expires_in_hours,json=expiresInHours,proto3" json:"expires_in_hours,omitempty"`
+	AllowedLanguages []string               `protobuf:"bytes,7,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	Instructions     string                 `protobuf:"bytes,8,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	PassingThreshold int32                  `protobuf:"varint,9,opt,name=passing_threshold,json=passingThreshold,proto3" json:"passing_threshold,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AssessmentTemplate) Reset() {
+	*x = AssessmentTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessmentTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentTemplate) ProtoMessage() {}
+
+func (x *AssessmentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentTemplate.ProtoReflect.Descriptor instead.
+func (*AssessmentTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssessmentTemplate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssessmentTemplate) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *AssessmentTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssessmentTemplate) GetProblems() []*AssessmentProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *AssessmentTemplate) GetTestDurationMinutes() int32 {
+	if x != nil {
+		return x.TestDurationMinutes
+	}
+	return 0
+}
+
+func (x *AssessmentTemplate) GetExpiresInHours() int32 {
+	if x != nil {
+		return x.ExpiresInHours
+	}
+	return 0
+}
+
+func (x *AssessmentTemplate) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
+}
+
+func (x *AssessmentTemplate) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *AssessmentTemplate) GetPassingThreshold() int32 {
+	if x != nil {
+		return x.PassingThreshold
+	}
+	return 0
+}
+
+func (x *AssessmentTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AssessmentTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateAssessmentTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id, created_at and updated_at are set by the service.
+	Template *AssessmentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateAssessmentTemplateRequest) Reset() {
+	*x = CreateAssessmentTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssessmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssessmentTemplateRequest) ProtoMessage() {}
+
+func (x *CreateAssessmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssessmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssessmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateAssessmentTemplateRequest) GetTemplate() *AssessmentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateAssessmentTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *AssessmentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateAssessmentTemplateResponse) Reset() {
+	*x = CreateAssessmentTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssessmentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssessmentTemplateResponse) ProtoMessage() {}
+
+func (x *CreateAssessmentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssessmentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssessmentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateAssessmentTemplateResponse) GetTemplate() *AssessmentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetAssessmentTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId int32 `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *GetAssessmentTemplateRequest) Reset() {
+	*x = GetAssessmentTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssessmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentTemplateRequest) ProtoMessage() {}
+
+func (x *GetAssessmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAssessmentTemplateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAssessmentTemplateRequest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type GetAssessmentTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *AssessmentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *GetAssessmentTemplateResponse) Reset() {
+	*x = GetAssessmentTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssessmentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentTemplateResponse) ProtoMessage() {}
+
+func (x *GetAssessmentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetAssessmentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAssessmentTemplateResponse) GetTemplate() *AssessmentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListAssessmentTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId int32 `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *ListAssessmentTemplatesRequest) Reset() {
+	*x = ListAssessmentTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAssessmentTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentTemplatesRequest) ProtoMessage() {}
+
+func (x *ListAssessmentTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssessmentTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAssessmentTemplatesRequest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type ListAssessmentTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Templates ordered by name.
+	Templates []*AssessmentTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListAssessmentTemplatesResponse) Reset() {
+	*x = ListAssessmentTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAssessmentTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentTemplatesResponse) ProtoMessage() {}
+
+func (x *ListAssessmentTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListAssessmentTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAssessmentTemplatesResponse) GetTemplates() []*AssessmentTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type UpdateAssessmentTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identified by id and company_id.
+	Template *AssessmentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateAssessmentTemplateRequest) Reset() {
+	*x = UpdateAssessmentTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssessmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssessmentTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateAssessmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssessmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssessmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateAssessmentTemplateRequest) GetTemplate() *AssessmentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateAssessmentTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *AssessmentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateAssessmentTemplateResponse) Reset() {
+	*x = UpdateAssessmentTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssessmentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssessmentTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateAssessmentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssessmentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAssessmentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateAssessmentTemplateResponse) GetTemplate() *AssessmentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteAssessmentTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId int32 `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *DeleteAssessmentTemplateRequest) Reset() {
+	*x = DeleteAssessmentTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAssessmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteAssessmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteAssessmentTemplateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAssessmentTemplateRequest) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type DeleteAssessmentTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAssessmentTemplateResponse) Reset() {
+	*x = DeleteAssessmentTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAssessmentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteAssessmentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{33}
+}
+
+type WatchTestEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AfterEventId string `protobuf:"bytes,1,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	// Name of the consumer whose acknowledged position the stream resumes
+	// from; empty for an anonymous stream.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (x *WatchTestEventsRequest) Reset() {
+	*x = WatchTestEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTestEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTestEventsRequest) ProtoMessage() {}
+
+func (x *WatchTestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTestEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WatchTestEventsRequest) GetAfterEventId() string {
+	if x != nil {
+		return x.AfterEventId
+	}
+	return ""
+}
+
+func (x *WatchTestEventsRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+type TestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event IDs increase in the order the events happened.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of test.started, test.submitted, test.expired, test.graded.
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Test       *CodingTest            `protobuf:"bytes,4,opt,name=test,proto3" json:"test,omitempty"`
+}
+
+func (x *TestEvent) Reset() {
+	*x = TestEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestEvent) ProtoMessage() {}
+
+func (x *TestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestEvent.ProtoReflect.Descriptor instead.
+func (*TestEvent) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TestEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TestEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *TestEvent) GetTest() *CodingTest {
+	if x != nil {
+		return x.Test
+	}
+	return nil
+}
+
+type AckTestEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	EventId  string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (x *AckTestEventRequest) Reset() {
+	*x = AckTestEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckTestEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckTestEventRequest) ProtoMessage() {}
+
+func (x *AckTestEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckTestEventRequest.ProtoReflect.Descriptor instead.
+func (*AckTestEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AckTestEventRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *AckTestEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type AckTestEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AckTestEventResponse) Reset() {
+	*x = AckTestEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckTestEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckTestEventResponse) ProtoMessage() {}
+
+func (x *AckTestEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_coding_tests_v1_coding_test_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckTestEventResponse.ProtoReflect.Descriptor instead.
+func (*AckTestEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP(), []int{37}
+}
+
 var File_proto_coding_tests_v1_coding_test_proto protoreflect.FileDescriptor
 
 var file_proto_coding_tests_v1_coding_test_proto_rawDesc = []byte{
@@ -742,105 +2476,419 @@ var file_proto_coding_tests_v1_coding_test_proto_rawDesc = []byte{
 	0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x53,
 	0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f,
-	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2f, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x52, 0x04, 0x74, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x37, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x4c,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x54, 0x65, 0x73, 0x74, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22, 0xf7, 0x04, 0x0a,
-	0x0a, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x14,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01,
+	0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x0c, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x01, 0x0a,
+	0x10, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x41, 0x0a,
+	0x11, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x22, 0x63, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xd6, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x5b, 0x0a, 0x14, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x52, 0x04,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x37, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
+	0x64, 0x22, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0xc5, 0x07, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
-	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x65, 0x73, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x73, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xce, 0x01, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3d,
+	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xd8, 0x03, 0x0a, 0x11, 0x43, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x54, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0a,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe9, 0x03,
+	0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x65, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x62, 0x0a, 0x1f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
 	0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x54, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x63, 0x0a,
+	0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x22, 0x4d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
+	0x64, 0x22, 0x60, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x1f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x63,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x74, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x41, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf0, 0x0c, 0x0a, 0x11,
+	0x43, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x55, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x54, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x0c, 0x41, 0x63, 0x6b, 0x54,
+	0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x54, 0x65,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27,
+	0x2e, 0x41, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x2d, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
 	0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x41,
+	0x5a, 0x3f, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x65, 0x73, 0x74, 0x73, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -855,45 +2903,115 @@ func file_proto_coding_tests_v1_coding_test_proto_rawDescGZIP() []byte {
 	return file_proto_coding_tests_v1_coding_test_proto_rawDescData
 }
 
-var file_proto_coding_tests_v1_coding_test_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_coding_tests_v1_coding_test_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_proto_coding_tests_v1_coding_test_proto_goTypes = []interface{}{
-	(*VerifyTestRequest)(nil),       // 0: coding_tests.v1.VerifyTestRequest
-	(*VerifyTestResponse)(nil),      // 1: coding_tests.v1.VerifyTestResponse
-	(*StartTestRequest)(nil),        // 2: coding_tests.v1.StartTestRequest
-	(*StartTestResponse)(nil),       // 3: coding_tests.v1.StartTestResponse
-	(*SubmitTestRequest)(nil),       // 4: coding_tests.v1.SubmitTestRequest
-	(*SubmitTestResponse)(nil),      // 5: coding_tests.v1.SubmitTestResponse
-	(*GenerateTestRequest)(nil),     // 6: coding_tests.v1.GenerateTestRequest
-	(*GenerateTestResponse)(nil),    // 7: coding_tests.v1.GenerateTestResponse
-	(*GetCompanyTestsRequest)(nil),  // 8: coding_tests.v1.GetCompanyTestsRequest
-	(*GetCompanyTestsResponse)(nil), // 9: coding_tests.v1.GetCompanyTestsResponse
-	(*CodingTest)(nil),              // 10: coding_tests.v1.CodingTest
-	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
+	(*VerifyTestRequest)(nil),                // 0: coding_tests.v1.VerifyTestRequest
+	(*VerifyTestResponse)(nil),               // 1: coding_tests.v1.VerifyTestResponse
+	(*StartTestRequest)(nil),                 // 2: coding_tests.v1.StartTestRequest
+	(*StartTestResponse)(nil),                // 3: coding_tests.v1.StartTestResponse
+	(*SubmitTestRequest)(nil),                // 4: coding_tests.v1.SubmitTestRequest
+	(*SubmitTestResponse)(nil),               // 5: coding_tests.v1.SubmitTestResponse
+	(*SubmitProblemRequest)(nil),             // 6: coding_tests.v1.SubmitProblemRequest
+	(*SubmitProblemResponse)(nil),            // 7: coding_tests.v1.SubmitProblemResponse
+	(*AutoSubmitTestRequest)(nil),            // 8: coding_tests.v1.AutoSubmitTestRequest
+	(*AutoSubmitTestResponse)(nil),           // 9: coding_tests.v1.AutoSubmitTestResponse
+	(*Draft)(nil),                            // 10: coding_tests.v1.Draft
+	(*DraftVersion)(nil),                     // 11: coding_tests.v1.DraftVersion
+	(*SaveDraftRequest)(nil),                 // 12: coding_tests.v1.SaveDraftRequest
+	(*SaveDraftResponse)(nil),                // 13: coding_tests.v1.SaveDraftResponse
+	(*GetDraftRequest)(nil),                  // 14: coding_tests.v1.GetDraftRequest
+	(*GetDraftResponse)(nil),                 // 15: coding_tests.v1.GetDraftResponse
+	(*GenerateTestRequest)(nil),              // 16: coding_tests.v1.GenerateTestRequest
+	(*GenerateTestResponse)(nil),             // 17: coding_tests.v1.GenerateTestResponse
+	(*GetCompanyTestsRequest)(nil),           // 18: coding_tests.v1.GetCompanyTestsRequest
+	(*GetCompanyTestsResponse)(nil),          // 19: coding_tests.v1.GetCompanyTestsResponse
+	(*CodingTest)(nil),                       // 20: coding_tests.v1.CodingTest
+	(*AssessmentProblem)(nil),                // 21: coding_tests.v1.AssessmentProblem
+	(*ProblemSubmission)(nil),                // 22: coding_tests.v1.ProblemSubmission
+	(*AssessmentTemplate)(nil),               // 23: coding_tests.v1.AssessmentTemplate
+	(*CreateAssessmentTemplateRequest)(nil),  // 24: coding_tests.v1.CreateAssessmentTemplateRequest
+	(*CreateAssessmentTemplateResponse)(nil), // 25: coding_tests.v1.CreateAssessmentTemplateResponse
+	(*GetAssessmentTemplateRequest)(nil),     // 26: coding_tests.v1.GetAssessmentTemplateRequest
+	(*GetAssessmentTemplateResponse)(nil),    // 27: coding_tests.v1.GetAssessmentTemplateResponse
+	(*ListAssessmentTemplatesRequest)(nil),   // 28: coding_tests.v1.ListAssessmentTemplatesRequest
+	(*ListAssessmentTemplatesResponse)(nil),  // 29: coding_tests.v1.ListAssessmentTemplatesResponse
+	(*UpdateAssessmentTemplateRequest)(nil),  // 30: coding_tests.v1.UpdateAssessmentTemplateRequest
+	(*UpdateAssessmentTemplateResponse)(nil), // 31: coding_tests.v1.UpdateAssessmentTemplateResponse
+	(*DeleteAssessmentTemplateRequest)(nil),  // 32: coding_tests.v1.DeleteAssessmentTemplateRequest
+	(*DeleteAssessmentTemplateResponse)(nil), // 33: coding_tests.v1.DeleteAssessmentTemplateResponse
+	(*WatchTestEventsRequest)(nil),           // 34: coding_tests.v1.WatchTestEventsRequest
+	(*TestEvent)(nil),                        // 35: coding_tests.v1.TestEvent
+	(*AckTestEventRequest)(nil),              // 36: coding_tests.v1.AckTestEventRequest
+	(*AckTestEventResponse)(nil),             // 37: coding_tests.v1.AckTestEventResponse
+	(*timestamppb.Timestamp)(nil),            // 38: google.protobuf.Timestamp
 }
 var file_proto_coding_tests_v1_coding_test_proto_depIdxs = []int32{
-	10, // 0: coding_tests.v1.VerifyTestResponse.test:type_name -> coding_tests.v1.CodingTest
-	10, // 1: coding_tests.v1.GenerateTestResponse.test:type_name -> coding_tests.v1.CodingTest
-	10, // 2: coding_tests.v1.GetCompanyTestsResponse.tests:type_name -> coding_tests.v1.CodingTest
-	11, // 3: coding_tests.v1.CodingTest.started_at:type_name -> google.protobuf.Timestamp
-	11, // 4: coding_tests.v1.CodingTest.completed_at:type_name -> google.protobuf.Timestamp
-	11, // 5: coding_tests.v1.CodingTest.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 6: coding_tests.v1.CodingTest.created_at:type_name -> google.protobuf.Timestamp
-	11, // 7: coding_tests.v1.CodingTest.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: coding_tests.v1.CodingTestService.VerifyTest:input_type -> coding_tests.v1.VerifyTestRequest
-	2,  // 9: coding_tests.v1.CodingTestService.StartTest:input_type -> coding_tests.v1.StartTestRequest
-	4,  // 10: coding_tests.v1.CodingTestService.SubmitTest:input_type -> coding_tests.v1.SubmitTestRequest
-	6,  // 11: coding_tests.v1.CodingTestService.GenerateTest:input_type -> coding_tests.v1.GenerateTestRequest
-	8,  // 12: coding_tests.v1.CodingTestService.GetCompanyTests:input_type -> coding_tests.v1.GetCompanyTestsRequest
-	1,  // 13: coding_tests.v1.CodingTestService.VerifyTest:output_type -> coding_tests.v1.VerifyTestResponse
-	3,  // 14: coding_tests.v1.CodingTestService.StartTest:output_type -> coding_tests.v1.StartTestResponse
-	5,  // 15: coding_tests.v1.CodingTestService.SubmitTest:output_type -> coding_tests.v1.SubmitTestResponse
-	7,  // 16: coding_tests.v1.CodingTestService.GenerateTest:output_type -> coding_tests.v1.GenerateTestResponse
-	9,  // 17: coding_tests.v1.CodingTestService.GetCompanyTests:output_type -> coding_tests.v1.GetCompanyTestsResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	20, // 0: coding_tests.v1.VerifyTestResponse.test:type_name -> coding_tests.v1.CodingTest
+	22, // 1: coding_tests.v1.SubmitProblemResponse.submission:type_name -> coding_tests.v1.ProblemSubmission
+	38, // 2: coding_tests.v1.Draft.saved_at:type_name -> google.protobuf.Timestamp
+	38, // 3: coding_tests.v1.DraftVersion.saved_at:type_name -> google.protobuf.Timestamp
+	10, // 4: coding_tests.v1.SaveDraftResponse.draft:type_name -> coding_tests.v1.Draft
+	10, // 5: coding_tests.v1.GetDraftResponse.draft:type_name -> coding_tests.v1.Draft
+	11, // 6: coding_tests.v1.GetDraftResponse.versions:type_name -> coding_tests.v1.DraftVersion
+	21, // 7: coding_tests.v1.GenerateTestRequest.problems:type_name -> coding_tests.v1.AssessmentProblem
+	20, // 8: coding_tests.v1.GenerateTestResponse.test:type_name -> coding_tests.v1.CodingTest
+	20, // 9: coding_tests.v1.GetCompanyTestsResponse.tests:type_name -> coding_tests.v1.CodingTest
+	38, // 10: coding_tests.v1.CodingTest.started_at:type_name -> google.protobuf.Timestamp
+	38, // 11: coding_tests.v1.CodingTest.completed_at:type_name -> google.protobuf.Timestamp
+	38, // 12: coding_tests.v1.CodingTest.expires_at:type_name -> google.protobuf.Timestamp
+	38, // 13: coding_tests.v1.CodingTest.created_at:type_name -> google.protobuf.Timestamp
+	38, // 14: coding_tests.v1.CodingTest.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 15: coding_tests.v1.CodingTest.problems:type_name -> coding_tests.v1.AssessmentProblem
+	22, // 16: coding_tests.v1.CodingTest.submissions:type_name -> coding_tests.v1.ProblemSubmission
+	38, // 17: coding_tests.v1.ProblemSubmission.submitted_at:type_name -> google.protobuf.Timestamp
+	21, // 18: coding_tests.v1.AssessmentTemplate.problems:type_name -> coding_tests.v1.AssessmentProblem
+	38, // 19: coding_tests.v1.AssessmentTemplate.created_at:type_name -> google.protobuf.Timestamp
+	38, // 20: coding_tests.v1.AssessmentTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 21: coding_tests.v1.CreateAssessmentTemplateRequest.template:type_name -> coding_tests.v1.AssessmentTemplate
+	23, // 22: coding_tests.v1.CreateAssessmentTemplateResponse.template:type_name -> coding_tests.v1.AssessmentTemplate
+	23, // 23: coding_tests.v1.GetAssessmentTemplateResponse.template:type_name -> coding_tests.v1.AssessmentTemplate
+	23, // 24: coding_tests.v1.ListAssessmentTemplatesResponse.templates:type_name -> coding_tests.v1.AssessmentTemplate
+	23, // 25: coding_tests.v1.UpdateAssessmentTemplateRequest.template:type_name -> coding_tests.v1.AssessmentTemplate
+	23, // 26: coding_tests.v1.UpdateAssessmentTemplateResponse.template:type_name -> coding_tests.v1.AssessmentTemplate
+	38, // 27: coding_tests.v1.TestEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	20, // 28: coding_tests.v1.TestEvent.test:type_name -> coding_tests.v1.CodingTest
+	0,  // 29: coding_tests.v1.CodingTestService.VerifyTest:input_type -> coding_tests.v1.VerifyTestRequest
+	2,  // 30: coding_tests.v1.CodingTestService.StartTest:input_type -> coding_tests.v1.StartTestRequest
+	4,  // 31: coding_tests.v1.CodingTestService.SubmitTest:input_type -> coding_tests.v1.SubmitTestRequest
+	6,  // 32: coding_tests.v1.CodingTestService.SubmitProblem:input_type -> coding_tests.v1.SubmitProblemRequest
+	8,  // 33: coding_tests.v1.CodingTestService.AutoSubmitTest:input_type -> coding_tests.v1.AutoSubmitTestRequest
+	12, // 34: coding_tests.v1.CodingTestService.SaveDraft:input_type -> coding_tests.v1.SaveDraftRequest
+	14, // 35: coding_tests.v1.CodingTestService.GetDraft:input_type -> coding_tests.v1.GetDraftRequest
+	16, // 36: coding_tests.v1.CodingTestService.GenerateTest:input_type -> coding_tests.v1.GenerateTestRequest
+	18, // 37: coding_tests.v1.CodingTestService.GetCompanyTests:input_type -> coding_tests.v1.GetCompanyTestsRequest
+	34, // 38: coding_tests.v1.CodingTestService.WatchTestEvents:input_type -> coding_tests.v1.WatchTestEventsRequest
+	36, // 39: coding_tests.v1.CodingTestService.AckTestEvent:input_type -> coding_tests.v1.AckTestEventRequest
+	24, // 40: coding_tests.v1.CodingTestService.CreateAssessmentTemplate:input_type -> coding_tests.v1.CreateAssessmentTemplateRequest
+	26, // 41: coding_tests.v1.CodingTestService.GetAssessmentTemplate:input_type -> coding_tests.v1.GetAssessmentTemplateRequest
+	28, // 42: coding_tests.v1.CodingTestService.ListAssessmentTemplates:input_type -> coding_tests.v1.ListAssessmentTemplatesRequest
+	30, // 43: coding_tests.v1.CodingTestService.UpdateAssessmentTemplate:input_type -> coding_tests.v1.UpdateAssessmentTemplateRequest
+	32, // 44: coding_tests.v1.CodingTestService.DeleteAssessmentTemplate:input_type -> coding_tests.v1.DeleteAssessmentTemplateRequest
+	1,  // 45: coding_tests.v1.CodingTestService.VerifyTest:output_type -> coding_tests.v1.VerifyTestResponse
+	3,  // 46: coding_tests.v1.CodingTestService.StartTest:output_type -> coding_tests.v1.StartTestResponse
+	5,  // 47: coding_tests.v1.CodingTestService.SubmitTest:output_type -> coding_tests.v1.SubmitTestResponse
+	7,  // 48: coding_tests.v1.CodingTestService.SubmitProblem:output_type -> coding_tests.v1.SubmitProblemResponse
+	9,  // 49: coding_tests.v1.CodingTestService.AutoSubmitTest:output_type -> coding_tests.v1.AutoSubmitTestResponse
+	13, // 50: coding_tests.v1.CodingTestService.SaveDraft:output_type -> coding_tests.v1.SaveDraftResponse
+	15, // 51: coding_tests.v1.CodingTestService.GetDraft:output_type -> coding_tests.v1.GetDraftResponse
+	17, // 52: coding_tests.v1.CodingTestService.GenerateTest:output_type -> coding_tests.v1.GenerateTestResponse
+	19, // 53: coding_tests.v1.CodingTestService.GetCompanyTests:output_type -> coding_tests.v1.GetCompanyTestsResponse
+	35, // 54: coding_tests.v1.CodingTestService.WatchTestEvents:output_type -> coding_tests.v1.TestEvent
+	37, // 55: coding_tests.v1.CodingTestService.AckTestEvent:output_type -> coding_tests.v1.AckTestEventResponse
+	25, // 56: coding_tests.v1.CodingTestService.CreateAssessmentTemplate:output_type -> coding_tests.v1.CreateAssessmentTemplateResponse
+	27, // 57: coding_tests.v1.CodingTestService.GetAssessmentTemplate:output_type -> coding_tests.v1.GetAssessmentTemplateResponse
+	29, // 58: coding_tests.v1.CodingTestService.ListAssessmentTemplates:output_type -> coding_tests.v1.ListAssessmentTemplatesResponse
+	31, // 59: coding_tests.v1.CodingTestService.UpdateAssessmentTemplate:output_type -> coding_tests.v1.UpdateAssessmentTemplateResponse
+	33, // 60: coding_tests.v1.CodingTestService.DeleteAssessmentTemplate:output_type -> coding_tests.v1.DeleteAssessmentTemplateResponse
+	45, // [45:61] is the sub-list for method output_type
+	29, // [29:45] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_proto_coding_tests_v1_coding_test_proto_init() }
@@ -914,8 +3032,56 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyTestResponse); i {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -926,8 +3092,8 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartTestRequest); i {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitProblemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -938,8 +3104,8 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartTestResponse); i {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitProblemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -950,8 +3116,8 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTestRequest); i {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSubmitTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -962,8 +3128,8 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTestResponse); i {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSubmitTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -974,7 +3140,79 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Draft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateTestRequest); i {
 			case 0:
 				return &v.state
@@ -986,7 +3224,7 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateTestResponse); i {
 			case 0:
 				return &v.state
@@ -998,7 +3236,7 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCompanyTestsRequest); i {
 			case 0:
 				return &v.state
@@ -1010,7 +3248,7 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCompanyTestsResponse); i {
 			case 0:
 				return &v.state
@@ -1022,7 +3260,7 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
-		file_proto_coding_tests_v1_coding_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CodingTest); i {
 			case 0:
 				return &v.state
@@ -1034,6 +3272,210 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 				return nil
 			}
 		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessmentProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProblemSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessmentTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssessmentTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssessmentTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssessmentTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssessmentTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssessmentTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssessmentTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssessmentTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssessmentTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAssessmentTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAssessmentTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTestEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckTestEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_coding_tests_v1_coding_test_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckTestEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1041,7 +3483,7 @@ func file_proto_coding_tests_v1_coding_test_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_coding_tests_v1_coding_test_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,8 +25,39 @@ type CodingTestServiceClient interface {
 	VerifyTest(ctx context.Context, in *VerifyTestRequest, opts ...grpc.CallOption) (*VerifyTestResponse, error)
 	StartTest(ctx context.Context, in *StartTestRequest, opts ...grpc.CallOption) (*StartTestResponse, error)
 	SubmitTest(ctx context.Context, in *SubmitTestRequest, opts ...grpc.CallOption) (*SubmitTestResponse, error)
+	// SubmitProblem submits the candidate's solution to one problem of a
+	// multi-problem assessment. A later submission replaces an earlier one.
+	SubmitProblem(ctx context.Context, in *SubmitProblemRequest, opts ...grpc.CallOption) (*SubmitProblemResponse, error)
+	// AutoSubmitTest submits a test whose time has run out on behalf of the
+	// candidate. Unlike SubmitTest it is accepted after the deadline. Returns
+	// FAILED_PRECONDITION when the test has already been submitted, so a late
+	// auto-submission never replaces the candidate's own.
+	AutoSubmitTest(ctx context.Context, in *AutoSubmitTestRequest, opts ...grpc.CallOption) (*AutoSubmitTestResponse, error)
+	// SaveDraft stores a new version of the candidate's code during a test.
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error)
+	// GetDraft returns a draft of a test and the versions saved so far.
+	// Returns NOT_FOUND when no draft has been saved.
+	GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error)
 	GenerateTest(ctx context.Context, in *GenerateTestRequest, opts ...grpc.CallOption) (*GenerateTestResponse, error)
 	GetCompanyTests(ctx context.Context, in *GetCompanyTestsRequest, opts ...grpc.CallOption) (*GetCompanyTestsResponse, error)
+	// WatchTestEvents streams lifecycle events of all tests, oldest first,
+	// starting after after_event_id, or with new events when it is empty.
+	// A stream opened for a consumer resumes after the last event the consumer
+	// acknowledged instead. Only one stream per consumer may be open at a
+	// time; another one fails with FAILED_PRECONDITION.
+	WatchTestEvents(ctx context.Context, in *WatchTestEventsRequest, opts ...grpc.CallOption) (CodingTestService_WatchTestEventsClient, error)
+	// AckTestEvent records that a consumer has handled every event up to and
+	// including event_id.
+	AckTestEvent(ctx context.Context, in *AckTestEventRequest, opts ...grpc.CallOption) (*AckTestEventResponse, error)
+	// Assessment templates are reusable test settings of a company. The
+	// template RPCs return NOT_FOUND for templates of other companies.
+	CreateAssessmentTemplate(ctx context.Context, in *CreateAssessmentTemplateRequest, opts ...grpc.CallOption) (*CreateAssessmentTemplateResponse, error)
+	GetAssessmentTemplate(ctx context.Context, in *GetAssessmentTemplateRequest, opts ...grpc.CallOption) (*GetAssessmentTemplateResponse, error)
+	ListAssessmentTemplates(ctx context.Context, in *ListAssessmentTemplatesRequest, opts ...grpc.CallOption) (*ListAssessmentTemplatesResponse, error)
+	// UpdateAssessmentTemplate replaces every field of a template. Tests
+	// already generated from it keep the settings they were generated with.
+	UpdateAssessmentTemplate(ctx context.Context, in *UpdateAssessmentTemplateRequest, opts ...grpc.CallOption) (*UpdateAssessmentTemplateResponse, error)
+	DeleteAssessmentTemplate(ctx context.Context, in *DeleteAssessmentTemplateRequest, opts ...grpc.CallOption) (*DeleteAssessmentTemplateResponse, error)
 }
 
 type codingTestServiceClient struct {
@@ -64,6 +95,42 @@ func (c *codingTestServiceClient) SubmitTest(ctx context.Context, in *SubmitTest
 	return out, nil
 }
 
+func (c *codingTestServiceClient) SubmitProblem(ctx context.Context, in *SubmitProblemRequest, opts ...grpc.CallOption) (*SubmitProblemResponse, error) {
+	out := new(SubmitProblemResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/SubmitProblem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) AutoSubmitTest(ctx context.Context, in *AutoSubmitTestRequest, opts ...grpc.CallOption) (*AutoSubmitTestResponse, error) {
+	out := new(AutoSubmitTestResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/AutoSubmitTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error) {
+	out := new(SaveDraftResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/SaveDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error) {
+	out := new(GetDraftResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/GetDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *codingTestServiceClient) GenerateTest(ctx context.Context, in *GenerateTestRequest, opts ...grpc.CallOption) (*GenerateTestResponse, error) {
 	out := new(GenerateTestResponse)
 	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/GenerateTest", in, out, opts...)
@@ -82,6 +149,92 @@ func (c *codingTestServiceClient) GetCompanyTests(ctx context.Context, in *GetCo
 	return out, nil
 }
 
+func (c *codingTestServiceClient) WatchTestEvents(ctx context.Context, in *WatchTestEventsRequest, opts ...grpc.CallOption) (CodingTestService_WatchTestEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CodingTestService_ServiceDesc.Streams[0], "/coding_tests.v1.CodingTestService/WatchTestEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &codingTestServiceWatchTestEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CodingTestService_WatchTestEventsClient interface {
+	Recv() (*TestEvent, error)
+	grpc.ClientStream
+}
+
+type codingTestServiceWatchTestEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *codingTestServiceWatchTestEventsClient) Recv() (*TestEvent, error) {
+	m := new(TestEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *codingTestServiceClient) AckTestEvent(ctx context.Context, in *AckTestEventRequest, opts ...grpc.CallOption) (*AckTestEventResponse, error) {
+	out := new(AckTestEventResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/AckTestEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) CreateAssessmentTemplate(ctx context.Context, in *CreateAssessmentTemplateRequest, opts ...grpc.CallOption) (*CreateAssessmentTemplateResponse, error) {
+	out := new(CreateAssessmentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/CreateAssessmentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) GetAssessmentTemplate(ctx context.Context, in *GetAssessmentTemplateRequest, opts ...grpc.CallOption) (*GetAssessmentTemplateResponse, error) {
+	out := new(GetAssessmentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/GetAssessmentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) ListAssessmentTemplates(ctx context.Context, in *ListAssessmentTemplatesRequest, opts ...grpc.CallOption) (*ListAssessmentTemplatesResponse, error) {
+	out := new(ListAssessmentTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/ListAssessmentTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) UpdateAssessmentTemplate(ctx context.Context, in *UpdateAssessmentTemplateRequest, opts ...grpc.CallOption) (*UpdateAssessmentTemplateResponse, error) {
+	out := new(UpdateAssessmentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/UpdateAssessmentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codingTestServiceClient) DeleteAssessmentTemplate(ctx context.Context, in *DeleteAssessmentTemplateRequest, opts ...grpc.CallOption) (*DeleteAssessmentTemplateResponse, error) {
+	out := new(DeleteAssessmentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coding_tests.v1.CodingTestService/DeleteAssessmentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CodingTestServiceServer is the server API for CodingTestService service.
 // All implementations must embed UnimplementedCodingTestServiceServer
 // for forward compatibility
@@ -89,8 +242,39 @@ type CodingTestServiceServer interface {
 	VerifyTest(context.Context, *VerifyTestRequest) (*VerifyTestResponse, error)
 	StartTest(context.Context, *StartTestRequest) (*StartTestResponse, error)
 	SubmitTest(context.Context, *SubmitTestRequest) (*SubmitTestResponse, error)
+	// SubmitProblem submits the candidate's solution to one problem of a
+	// multi-problem assessment. A later submission replaces an earlier one.
+	SubmitProblem(context.Context, *SubmitProblemRequest) (*SubmitProblemResponse, error)
+	// AutoSubmitTest submits a test whose time has run out on behalf of the
+	// candidate. Unlike SubmitTest it is accepted after the deadline. Returns
+	// FAILED_PRECONDITION when the test has already been submitted, so a late
+	// auto-submission never replaces the candidate's own.
+	AutoSubmitTest(context.Context, *AutoSubmitTestRequest) (*AutoSubmitTestResponse, error)
+	// SaveDraft stores a new version of the candidate's code during a test.
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error)
+	// GetDraft returns a draft of a test and the versions saved so far.
+	// Returns NOT_FOUND when no draft has been saved.
+	GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error)
 	GenerateTest(context.Context, *GenerateTestRequest) (*GenerateTestResponse, error)
 	GetCompanyTests(context.Context, *GetCompanyTestsRequest) (*GetCompanyTestsResponse, error)
+	// WatchTestEvents streams lifecycle events of all tests, oldest first,
+	// starting after after_event_id, or with new events when it is empty.
+	// A stream opened for a consumer resumes after the last event the consumer
+	// acknowledged instead. Only one stream per consumer may be open at a
+	// time; another one fails with FAILED_PRECONDITION.
+	WatchTestEvents(*WatchTestEventsRequest, CodingTestService_WatchTestEventsServer) error
+	// AckTestEvent records that a consumer has handled every event up to and
+	// including event_id.
+	AckTestEvent(context.Context, *AckTestEventRequest) (*AckTestEventResponse, error)
+	// Assessment templates are reusable test settings of a company. The
+	// template RPCs return NOT_FOUND for templates of other companies.
+	CreateAssessmentTemplate(context.Context, *CreateAssessmentTemplateRequest) (*CreateAssessmentTemplateResponse, error)
+	GetAssessmentTemplate(context.Context, *GetAssessmentTemplateRequest) (*GetAssessmentTemplateResponse, error)
+	ListAssessmentTemplates(context.Context, *ListAssessmentTemplatesRequest) (*ListAssessmentTemplatesResponse, error)
+	// UpdateAssessmentTemplate replaces every field of a template. Tests
+	// already generated from it keep the settings they were generated with.
+	UpdateAssessmentTemplate(context.Context, *UpdateAssessmentTemplateRequest) (*UpdateAssessmentTemplateResponse, error)
+	DeleteAssessmentTemplate(context.Context, *DeleteAssessmentTemplateRequest) (*DeleteAssessmentTemplateResponse, error)
 	mustEmbedUnimplementedCodingTestServiceServer()
 }
 
@@ -107,12 +291,45 @@ func (UnimplementedCodingTestServiceServer) StartTest(context.Context, *StartTes
 func (UnimplementedCodingTestServiceServer) SubmitTest(context.Context, *SubmitTestRequest) (*SubmitTestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTest not implemented")
 }
+func (UnimplementedCodingTestServiceServer) SubmitProblem(context.Context, *SubmitProblemRequest) (*SubmitProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProblem not implemented")
+}
+func (UnimplementedCodingTestServiceServer) AutoSubmitTest(context.Context, *AutoSubmitTestRequest) (*AutoSubmitTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSubmitTest not implemented")
+}
+func (UnimplementedCodingTestServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedCodingTestServiceServer) GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
 func (UnimplementedCodingTestServiceServer) GenerateTest(context.Context, *GenerateTestRequest) (*GenerateTestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateTest not implemented")
 }
 func (UnimplementedCodingTestServiceServer) GetCompanyTests(context.Context, *GetCompanyTestsRequest) (*GetCompanyTestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyTests not implemented")
 }
+func (UnimplementedCodingTestServiceServer) WatchTestEvents(*WatchTestEventsRequest, CodingTestService_WatchTestEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTestEvents not implemented")
+}
+func (UnimplementedCodingTestServiceServer) AckTestEvent(context.Context, *AckTestEventRequest) (*AckTestEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckTestEvent not implemented")
+}
+func (UnimplementedCodingTestServiceServer) CreateAssessmentTemplate(context.Context, *CreateAssessmentTemplateRequest) (*CreateAssessmentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssessmentTemplate not implemented")
+}
+func (UnimplementedCodingTestServiceServer) GetAssessmentTemplate(context.Context, *GetAssessmentTemplateRequest) (*GetAssessmentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentTemplate not implemented")
+}
+func (UnimplementedCodingTestServiceServer) ListAssessmentTemplates(context.Context, *ListAssessmentTemplatesRequest) (*ListAssessmentTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssessmentTemplates not implemented")
+}
+func (UnimplementedCodingTestServiceServer) UpdateAssessmentTemplate(context.Context, *UpdateAssessmentTemplateRequest) (*UpdateAssessmentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssessmentTemplate not implemented")
+}
+func (UnimplementedCodingTestServiceServer) DeleteAssessmentTemplate(context.Context, *DeleteAssessmentTemplateRequest) (*DeleteAssessmentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssessmentTemplate not implemented")
+}
 func (UnimplementedCodingTestServiceServer) mustEmbedUnimplementedCodingTestServiceServer() {}
 
 // UnsafeCodingTestServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +397,78 @@ func _CodingTestService_SubmitTest_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodingTestService_SubmitProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).SubmitProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/SubmitProblem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).SubmitProblem(ctx, req.(*SubmitProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_AutoSubmitTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoSubmitTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).AutoSubmitTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/AutoSubmitTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).AutoSubmitTest(ctx, req.(*AutoSubmitTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/SaveDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/GetDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).GetDraft(ctx, req.(*GetDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CodingTestService_GenerateTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateTestRequest)
 	if err := dec(in); err != nil {
@@ -216,6 +505,135 @@ func _CodingTestService_GetCompanyTests_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodingTestService_WatchTestEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTestEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CodingTestServiceServer).WatchTestEvents(m, &codingTestServiceWatchTestEventsServer{stream})
+}
+
+type CodingTestService_WatchTestEventsServer interface {
+	Send(*TestEvent) error
+	grpc.ServerStream
+}
+
+type codingTestServiceWatchTestEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *codingTestServiceWatchTestEventsServer) Send(m *TestEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CodingTestService_AckTestEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckTestEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).AckTestEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/AckTestEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).AckTestEvent(ctx, req.(*AckTestEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_CreateAssessmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssessmentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).CreateAssessmentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/CreateAssessmentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).CreateAssessmentTemplate(ctx, req.(*CreateAssessmentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_GetAssessmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).GetAssessmentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/GetAssessmentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).GetAssessmentTemplate(ctx, req.(*GetAssessmentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_ListAssessmentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssessmentTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).ListAssessmentTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/ListAssessmentTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).ListAssessmentTemplates(ctx, req.(*ListAssessmentTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_UpdateAssessmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssessmentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).UpdateAssessmentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/UpdateAssessmentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).UpdateAssessmentTemplate(ctx, req.(*UpdateAssessmentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodingTestService_DeleteAssessmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssessmentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodingTestServiceServer).DeleteAssessmentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coding_tests.v1.CodingTestService/DeleteAssessmentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodingTestServiceServer).DeleteAssessmentTemplate(ctx, req.(*DeleteAssessmentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CodingTestService_ServiceDesc is the grpc.ServiceDesc for CodingTestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -235,6 +653,22 @@ var CodingTestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitTest",
 			Handler:    _CodingTestService_SubmitTest_Handler,
 		},
+		{
+			MethodName: "SubmitProblem",
+			Handler:    _CodingTestService_SubmitProblem_Handler,
+		},
+		{
+			MethodName: "AutoSubmitTest",
+			Handler:    _CodingTestService_AutoSubmitTest_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _CodingTestService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _CodingTestService_GetDraft_Handler,
+		},
 		{
 			MethodName: "GenerateTest",
 			Handler:    _CodingTestService_GenerateTest_Handler,
@@ -243,7 +677,37 @@ var CodingTestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompanyTests",
 			Handler:    _CodingTestService_GetCompanyTests_Handler,
 		},
+		{
+			MethodName: "AckTestEvent",
+			Handler:    _CodingTestService_AckTestEvent_Handler,
+		},
+		{
+			MethodName: "CreateAssessmentTemplate",
+			Handler:    _CodingTestService_CreateAssessmentTemplate_Handler,
+		},
+		{
+			MethodName: "GetAssessmentTemplate",
+			Handler:    _CodingTestService_GetAssessmentTemplate_Handler,
+		},
+		{
+			MethodName: "ListAssessmentTemplates",
+			Handler:    _CodingTestService_ListAssessmentTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateAssessmentTemplate",
+			Handler:    _CodingTestService_UpdateAssessmentTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteAssessmentTemplate",
+			Handler:    _CodingTestService_DeleteAssessmentTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTestEvents",
+			Handler:       _CodingTestService_WatchTestEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/coding_tests/v1/coding_test.proto",
 }
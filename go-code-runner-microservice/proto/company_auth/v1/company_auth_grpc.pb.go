@@ -30,6 +30,23 @@ type CompanyAuthServiceClient interface {
 	GenerateAPIKey(ctx context.Context, in *GenerateAPIKeyRequest, opts ...grpc.CallOption) (*GenerateAPIKeyResponse, error)
 	// GenerateClientID generates a new client ID for a company
 	GenerateClientID(ctx context.Context, in *GenerateClientIDRequest, opts ...grpc.CallOption) (*GenerateClientIDResponse, error)
+	// ValidateToken resolves a login token to the company it was issued for
+	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
+	// CreateWebhookSubscription subscribes a URL to coding test events
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookSubscriptions returns a company's webhook subscriptions
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// DeleteWebhookSubscription removes a subscription owned by the company
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	// SaveWebhookDelivery creates or replaces a delivery in the webhook
+	// delivery log, which every gateway replica shares
+	SaveWebhookDelivery(ctx context.Context, in *SaveWebhookDeliveryRequest, opts ...grpc.CallOption) (*SaveWebhookDeliveryResponse, error)
+	// GetWebhookDelivery returns one of a company's deliveries with its body;
+	// success is false when there is none
+	GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryResponse, error)
+	// ListWebhookDeliveries returns a company's most recent deliveries, newest
+	// first and without their bodies. The service decides how many it keeps
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
 }
 
 type companyAuthServiceClient struct {
@@ -76,6 +93,69 @@ func (c *companyAuthServiceClient) GenerateClientID(ctx context.Context, in *Gen
 	return out, nil
 }
 
+func (c *companyAuthServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error) {
+	out := new(ValidateTokenResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/ValidateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/CreateWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/ListWebhookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/DeleteWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) SaveWebhookDelivery(ctx context.Context, in *SaveWebhookDeliveryRequest, opts ...grpc.CallOption) (*SaveWebhookDeliveryResponse, error) {
+	out := new(SaveWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/SaveWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryResponse, error) {
+	out := new(GetWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/GetWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyAuthServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/company_auth.v1.CompanyAuthService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompanyAuthServiceServer is the server API for CompanyAuthService service.
 // All implementations must embed UnimplementedCompanyAuthServiceServer
 // for forward compatibility
@@ -88,6 +168,23 @@ type CompanyAuthServiceServer interface {
 	GenerateAPIKey(context.Context, *GenerateAPIKeyRequest) (*GenerateAPIKeyResponse, error)
 	// GenerateClientID generates a new client ID for a company
 	GenerateClientID(context.Context, *GenerateClientIDRequest) (*GenerateClientIDResponse, error)
+	// ValidateToken resolves a login token to the company it was issued for
+	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
+	// CreateWebhookSubscription subscribes a URL to coding test events
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookSubscriptions returns a company's webhook subscriptions
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// DeleteWebhookSubscription removes a subscription owned by the company
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	// SaveWebhookDelivery creates or replaces a delivery in the webhook
+	// delivery log, which every gateway replica shares
+	SaveWebhookDelivery(context.Context, *SaveWebhookDeliveryRequest) (*SaveWebhookDeliveryResponse, error)
+	// GetWebhookDelivery returns one of a company's deliveries with its body;
+	// success is false when there is none
+	GetWebhookDelivery(context.Context, *GetWebhookDeliveryRequest) (*GetWebhookDeliveryResponse, error)
+	// ListWebhookDeliveries returns a company's most recent deliveries, newest
+	// first and without their bodies. The service decides how many it keeps
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
 	mustEmbedUnimplementedCompanyAuthServiceServer()
 }
 
@@ -107,6 +204,27 @@ func (UnimplementedCompanyAuthServiceServer) GenerateAPIKey(context.Context, *Ge
 func (UnimplementedCompanyAuthServiceServer) GenerateClientID(context.Context, *GenerateClientIDRequest) (*GenerateClientIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientID not implemented")
 }
+func (UnimplementedCompanyAuthServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) SaveWebhookDelivery(context.Context, *SaveWebhookDeliveryRequest) (*SaveWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWebhookDelivery not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) GetWebhookDelivery(context.Context, *GetWebhookDeliveryRequest) (*GetWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDelivery not implemented")
+}
+func (UnimplementedCompanyAuthServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
 func (UnimplementedCompanyAuthServiceServer) mustEmbedUnimplementedCompanyAuthServiceServer() {}
 
 // UnsafeCompanyAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +310,132 @@ func _CompanyAuthService_GenerateClientID_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CompanyAuthService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/ValidateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).ValidateToken(ctx, req.(*ValidateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/CreateWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/ListWebhookSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/DeleteWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_SaveWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).SaveWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/SaveWebhookDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).SaveWebhookDelivery(ctx, req.(*SaveWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_GetWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).GetWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/GetWebhookDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).GetWebhookDelivery(ctx, req.(*GetWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyAuthService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyAuthServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/company_auth.v1.CompanyAuthService/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyAuthServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CompanyAuthService_ServiceDesc is the grpc.ServiceDesc for CompanyAuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +459,34 @@ var CompanyAuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateClientID",
 			Handler:    _CompanyAuthService_GenerateClientID_Handler,
 		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _CompanyAuthService_ValidateToken_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _CompanyAuthService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _CompanyAuthService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _CompanyAuthService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "SaveWebhookDelivery",
+			Handler:    _CompanyAuthService_SaveWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "GetWebhookDelivery",
+			Handler:    _CompanyAuthService_GetWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _CompanyAuthService_ListWebhookDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/company_auth/v1/company_auth.proto",
@@ -25,6 +25,16 @@ type ProblemServiceClient interface {
 	GetProblem(ctx context.Context, in *GetProblemRequest, opts ...grpc.CallOption) (*GetProblemResponse, error)
 	ListProblems(ctx context.Context, in *ListProblemsRequest, opts ...grpc.CallOption) (*ListProblemsResponse, error)
 	GetTestCasesByProblemID(ctx context.Context, in *GetTestCasesByProblemIDRequest, opts ...grpc.CallOption) (*GetTestCasesByProblemIDResponse, error)
+	CreateProblem(ctx context.Context, in *CreateProblemRequest, opts ...grpc.CallOption) (*CreateProblemResponse, error)
+	UpdateProblem(ctx context.Context, in *UpdateProblemRequest, opts ...grpc.CallOption) (*UpdateProblemResponse, error)
+	DeleteProblem(ctx context.Context, in *DeleteProblemRequest, opts ...grpc.CallOption) (*DeleteProblemResponse, error)
+	CreateTestCase(ctx context.Context, in *CreateTestCaseRequest, opts ...grpc.CallOption) (*CreateTestCaseResponse, error)
+	UpdateTestCase(ctx context.Context, in *UpdateTestCaseRequest, opts ...grpc.CallOption) (*UpdateTestCaseResponse, error)
+	DeleteTestCase(ctx context.Context, in *DeleteTestCaseRequest, opts ...grpc.CallOption) (*DeleteTestCaseResponse, error)
+	ReorderTestCases(ctx context.Context, in *ReorderTestCasesRequest, opts ...grpc.CallOption) (*ReorderTestCasesResponse, error)
+	BulkCreateTestCases(ctx context.Context, in *BulkCreateTestCasesRequest, opts ...grpc.CallOption) (*BulkCreateTestCasesResponse, error)
+	GetProblemVersion(ctx context.Context, in *GetProblemVersionRequest, opts ...grpc.CallOption) (*GetProblemVersionResponse, error)
+	ListProblemVersions(ctx context.Context, in *ListProblemVersionsRequest, opts ...grpc.CallOption) (*ListProblemVersionsResponse, error)
 }
 
 type problemServiceClient struct {
@@ -62,6 +72,96 @@ func (c *problemServiceClient) GetTestCasesByProblemID(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *problemServiceClient) CreateProblem(ctx context.Context, in *CreateProblemRequest, opts ...grpc.CallOption) (*CreateProblemResponse, error) {
+	out := new(CreateProblemResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/CreateProblem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) UpdateProblem(ctx context.Context, in *UpdateProblemRequest, opts ...grpc.CallOption) (*UpdateProblemResponse, error) {
+	out := new(UpdateProblemResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/UpdateProblem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) DeleteProblem(ctx context.Context, in *DeleteProblemRequest, opts ...grpc.CallOption) (*DeleteProblemResponse, error) {
+	out := new(DeleteProblemResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/DeleteProblem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) CreateTestCase(ctx context.Context, in *CreateTestCaseRequest, opts ...grpc.CallOption) (*CreateTestCaseResponse, error) {
+	out := new(CreateTestCaseResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/CreateTestCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) UpdateTestCase(ctx context.Context, in *UpdateTestCaseRequest, opts ...grpc.CallOption) (*UpdateTestCaseResponse, error) {
+	out := new(UpdateTestCaseResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/UpdateTestCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) DeleteTestCase(ctx context.Context, in *DeleteTestCaseRequest, opts ...grpc.CallOption) (*DeleteTestCaseResponse, error) {
+	out := new(DeleteTestCaseResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/DeleteTestCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) ReorderTestCases(ctx context.Context, in *ReorderTestCasesRequest, opts ...grpc.CallOption) (*ReorderTestCasesResponse, error) {
+	out := new(ReorderTestCasesResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/ReorderTestCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) BulkCreateTestCases(ctx context.Context, in *BulkCreateTestCasesRequest, opts ...grpc.CallOption) (*BulkCreateTestCasesResponse, error) {
+	out := new(BulkCreateTestCasesResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/BulkCreateTestCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) GetProblemVersion(ctx context.Context, in *GetProblemVersionRequest, opts ...grpc.CallOption) (*GetProblemVersionResponse, error) {
+	out := new(GetProblemVersionResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/GetProblemVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *problemServiceClient) ListProblemVersions(ctx context.Context, in *ListProblemVersionsRequest, opts ...grpc.CallOption) (*ListProblemVersionsResponse, error) {
+	out := new(ListProblemVersionsResponse)
+	err := c.cc.Invoke(ctx, "/problems.v1.ProblemService/ListProblemVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProblemServiceServer is the server API for ProblemService service.
 // All implementations must embed UnimplementedProblemServiceServer
 // for forward compatibility
@@ -69,6 +169,16 @@ type ProblemServiceServer interface {
 	GetProblem(context.Context, *GetProblemRequest) (*GetProblemResponse, error)
 	ListProblems(context.Context, *ListProblemsRequest) (*ListProblemsResponse, error)
 	GetTestCasesByProblemID(context.Context, *GetTestCasesByProblemIDRequest) (*GetTestCasesByProblemIDResponse, error)
+	CreateProblem(context.Context, *CreateProblemRequest) (*CreateProblemResponse, error)
+	UpdateProblem(context.Context, *UpdateProblemRequest) (*UpdateProblemResponse, error)
+	DeleteProblem(context.Context, *DeleteProblemRequest) (*DeleteProblemResponse, error)
+	CreateTestCase(context.Context, *CreateTestCaseRequest) (*CreateTestCaseResponse, error)
+	UpdateTestCase(context.Context, *UpdateTestCaseRequest) (*UpdateTestCaseResponse, error)
+	DeleteTestCase(context.Context, *DeleteTestCaseRequest) (*DeleteTestCaseResponse, error)
+	ReorderTestCases(context.Context, *ReorderTestCasesRequest) (*ReorderTestCasesResponse, error)
+	BulkCreateTestCases(context.Context, *BulkCreateTestCasesRequest) (*BulkCreateTestCasesResponse, error)
+	GetProblemVersion(context.Context, *GetProblemVersionRequest) (*GetProblemVersionResponse, error)
+	ListProblemVersions(context.Context, *ListProblemVersionsRequest) (*ListProblemVersionsResponse, error)
 	mustEmbedUnimplementedProblemServiceServer()
 }
 
@@ -85,6 +195,36 @@ func (UnimplementedProblemServiceServer) ListProblems(context.Context, *ListProb
 func (UnimplementedProblemServiceServer) GetTestCasesByProblemID(context.Context, *GetTestCasesByProblemIDRequest) (*GetTestCasesByProblemIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTestCasesByProblemID not implemented")
 }
+func (UnimplementedProblemServiceServer) CreateProblem(context.Context, *CreateProblemRequest) (*CreateProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProblem not implemented")
+}
+func (UnimplementedProblemServiceServer) UpdateProblem(context.Context, *UpdateProblemRequest) (*UpdateProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProblem not implemented")
+}
+func (UnimplementedProblemServiceServer) DeleteProblem(context.Context, *DeleteProblemRequest) (*DeleteProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProblem not implemented")
+}
+func (UnimplementedProblemServiceServer) CreateTestCase(context.Context, *CreateTestCaseRequest) (*CreateTestCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTestCase not implemented")
+}
+func (UnimplementedProblemServiceServer) UpdateTestCase(context.Context, *UpdateTestCaseRequest) (*UpdateTestCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTestCase not implemented")
+}
+func (UnimplementedProblemServiceServer) DeleteTestCase(context.Context, *DeleteTestCaseRequest) (*DeleteTestCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTestCase not implemented")
+}
+func (UnimplementedProblemServiceServer) ReorderTestCases(context.Context, *ReorderTestCasesRequest) (*ReorderTestCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderTestCases not implemented")
+}
+func (UnimplementedProblemServiceServer) BulkCreateTestCases(context.Context, *BulkCreateTestCasesRequest) (*BulkCreateTestCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateTestCases not implemented")
+}
+func (UnimplementedProblemServiceServer) GetProblemVersion(context.Context, *GetProblemVersionRequest) (*GetProblemVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProblemVersion not implemented")
+}
+func (UnimplementedProblemServiceServer) ListProblemVersions(context.Context, *ListProblemVersionsRequest) (*ListProblemVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProblemVersions not implemented")
+}
 func (UnimplementedProblemServiceServer) mustEmbedUnimplementedProblemServiceServer() {}
 
 // UnsafeProblemServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +292,186 @@ func _ProblemService_GetTestCasesByProblemID_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProblemService_CreateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).CreateProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/CreateProblem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).CreateProblem(ctx, req.(*CreateProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_UpdateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).UpdateProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/UpdateProblem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).UpdateProblem(ctx, req.(*UpdateProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_DeleteProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).DeleteProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/DeleteProblem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).DeleteProblem(ctx, req.(*DeleteProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_CreateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).CreateTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/CreateTestCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).CreateTestCase(ctx, req.(*CreateTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_UpdateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).UpdateTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/UpdateTestCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).UpdateTestCase(ctx, req.(*UpdateTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_DeleteTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).DeleteTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/DeleteTestCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).DeleteTestCase(ctx, req.(*DeleteTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_ReorderTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderTestCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).ReorderTestCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/ReorderTestCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).ReorderTestCases(ctx, req.(*ReorderTestCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_BulkCreateTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateTestCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).BulkCreateTestCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/BulkCreateTestCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).BulkCreateTestCases(ctx, req.(*BulkCreateTestCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_GetProblemVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).GetProblemVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/GetProblemVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).GetProblemVersion(ctx, req.(*GetProblemVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProblemService_ListProblemVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProblemVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProblemServiceServer).ListProblemVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/problems.v1.ProblemService/ListProblemVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProblemServiceServer).ListProblemVersions(ctx, req.(*ListProblemVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProblemService_ServiceDesc is the grpc.ServiceDesc for ProblemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +491,46 @@ var ProblemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTestCasesByProblemID",
 			Handler:    _ProblemService_GetTestCasesByProblemID_Handler,
 		},
+		{
+			MethodName: "CreateProblem",
+			Handler:    _ProblemService_CreateProblem_Handler,
+		},
+		{
+			MethodName: "UpdateProblem",
+			Handler:    _ProblemService_UpdateProblem_Handler,
+		},
+		{
+			MethodName: "DeleteProblem",
+			Handler:    _ProblemService_DeleteProblem_Handler,
+		},
+		{
+			MethodName: "CreateTestCase",
+			Handler:    _ProblemService_CreateTestCase_Handler,
+		},
+		{
+			MethodName: "UpdateTestCase",
+			Handler:    _ProblemService_UpdateTestCase_Handler,
+		},
+		{
+			MethodName: "DeleteTestCase",
+			Handler:    _ProblemService_DeleteTestCase_Handler,
+		},
+		{
+			MethodName: "ReorderTestCases",
+			Handler:    _ProblemService_ReorderTestCases_Handler,
+		},
+		{
+			MethodName: "BulkCreateTestCases",
+			Handler:    _ProblemService_BulkCreateTestCases_Handler,
+		},
+		{
+			MethodName: "GetProblemVersion",
+			Handler:    _ProblemService_GetProblemVersion_Handler,
+		},
+		{
+			MethodName: "ListProblemVersions",
+			Handler:    _ProblemService_ListProblemVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/problems/v1/problems.proto",
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ActionCompanyRegister       = "company.register"
+	ActionCompanyLogin          = "company.login"
+	ActionAPIKeyGenerate        = "company.api_key.generate"
+	ActionClientIDGenerate      = "company.client_id.generate"
+	ActionCodingTestGenerate    = "coding_test.generate"
+	ActionCandidateTokenReissue = "coding_test.candidate_token.reissue"
+	ActionProblemCreate         = "problem.create"
+	ActionProblemUpdate         = "problem.update"
+	ActionProblemDelete         = "problem.delete"
+	ActionProblemImport         = "problem.import"
+	ActionProblemExport         = "problem.export"
+	ActionTestCaseCreate        = "test_case.create"
+	ActionTestCaseUpdate        = "test_case.update"
+	ActionTestCaseDelete        = "test_case.delete"
+	ActionTestCaseReorder       = "test_case.reorder"
+	ActionTestCaseBulkCreate    = "test_case.bulk_create"
+	ActionExecuteBatch          = "execute.batch"
+	ActionWebhookCreate         = "webhook.create"
+	ActionWebhookDelete         = "webhook.delete"
+	ActionWebhookRedeliver      = "webhook.redeliver"
+	ActionCallbackSecretRead    = "webhook.callback_secret.read"
+	ActionTemplateCreate        = "assessment_template.create"
+	ActionTemplateUpdate        = "assessment_template.update"
+	ActionTemplateDelete        = "assessment_template.delete"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeError   = "error"
+)
+
+// Event is a single entry in the audit trail. Events are never updated once
+// written.
+type Event struct {
+	ID            string            `json:"id"`
+	Time          time.Time         `json:"time"`
+	CompanyID     int               `json:"company_id,omitempty"`
+	Actor         string            `json:"actor,omitempty"`
+	Action        string            `json:"action"`
+	Outcome       string            `json:"outcome"`
+	ClientIP      string            `json:"client_ip,omitempty"`
+	RequestID     string            `json:"request_id,omitempty"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	Details       map[string]string `json:"details,omitempty"`
+}
+
+// Filter narrows a query over the audit trail. Zero values match everything.
+type Filter struct {
+	CompanyID int
+	Action    string
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+}
+
+func (f Filter) Match(e Event) bool {
+	if f.CompanyID != 0 && e.CompanyID != f.CompanyID {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && e.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Sink is the storage backend for audit events.
+type Sink interface {
+	Write(ctx context.Context, e Event) error
+	// Query returns matching events, newest first.
+	Query(ctx context.Context, f Filter) ([]Event, error)
+	Close() error
+}
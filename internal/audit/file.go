@@ -0,0 +1,187 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultMaxSize = 10 * 1024 * 1024
+
+// FileSink appends events as JSON lines to a file and rotates it once it
+// grows beyond MaxSize. Rotated files are kept next to the active one as
+// <name>-<timestamp><ext>.
+type FileSink struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileSink opens (or creates) the audit file at path. maxBackups of zero
+// keeps every rotated file.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("create audit directory: %w", err)
+	}
+
+	s := &FileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("open audit file %s: %w", s.path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat audit file %s: %w", s.path, err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) Write(_ context.Context, e Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal audit event: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write audit event: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("close audit file: %w", err)
+	}
+
+	ext := filepath.Ext(s.path)
+	rotated := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(s.path, ext), time.Now().UTC().Format("20060102T150405.000000000"), ext)
+	if err := os.Rename(s.path, rotated); err != nil {
+		return fmt.Errorf("rotate audit file: %w", err)
+	}
+
+	if s.maxBackups > 0 {
+		backups, err := s.backups()
+		if err == nil && len(backups) > s.maxBackups {
+			for _, old := range backups[:len(backups)-s.maxBackups] {
+				_ = os.Remove(old)
+			}
+		}
+	}
+
+	return s.open()
+}
+
+// backups lists rotated files, oldest first.
+func (s *FileSink) backups() ([]string, error) {
+	ext := filepath.Ext(s.path)
+	matches, err := filepath.Glob(strings.TrimSuffix(s.path, ext) + "-*" + ext)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func (s *FileSink) Query(ctx context.Context, f Filter) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := s.backups()
+	if err != nil {
+		return nil, fmt.Errorf("list audit files: %w", err)
+	}
+	files = append(files, s.path)
+
+	var events []Event
+	for i := len(files) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		matched, err := readEvents(files[i], f)
+		if err != nil {
+			return nil, err
+		}
+		// Each file is in write order, so reverse it to keep newest first.
+		for j := len(matched) - 1; j >= 0; j-- {
+			events = append(events, matched[j])
+			if f.Limit > 0 && len(events) >= f.Limit {
+				return events, nil
+			}
+		}
+	}
+	return events, nil
+}
+
+func readEvents(path string, f Filter) ([]Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open audit file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if f.Match(e) {
+			events = append(events, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit file %s: %w", path, err)
+	}
+	return events, nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
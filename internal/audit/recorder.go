@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go.uber.org/zap"
+)
+
+// Recorder fills in request metadata and hands events to a Sink. A nil
+// *Recorder drops every event, which is how auditing is disabled.
+type Recorder struct {
+	sink Sink
+}
+
+func NewRecorder(sink Sink) *Recorder {
+	return &Recorder{sink: sink}
+}
+
+// Record writes an event for the current request. An event without an actor
+// is attributed to the authenticated company, if any. Failures are logged
+// rather than returned so that auditing never breaks the request itself.
+func (r *Recorder) Record(c *gin.Context, e Event) {
+	if r == nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if e.Actor == "" {
+		e.Actor = middleware.CompanyEmailFromContext(c)
+	}
+	e.ID = uuid.New().String()
+	e.Time = time.Now().UTC()
+	e.ClientIP = c.ClientIP()
+	e.RequestID = logger.GetRequestID(ctx)
+	e.CorrelationID = logger.GetCorrelationID(ctx)
+
+	if err := r.sink.Write(ctx, e); err != nil {
+		logger.WithContext(ctx).Error("failed to write audit event",
+			zap.String("action", e.Action),
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *Recorder) Query(ctx context.Context, f Filter) ([]Event, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return r.sink.Query(ctx, f)
+}
+
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	return r.sink.Close()
+}
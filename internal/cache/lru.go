@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type item[K comparable, V any] struct {
+	key      K
+	value    V
+	storedAt time.Time
+}
+
+// LRU is a size-bounded, concurrency-safe cache that evicts the least recently
+// used entry once it is full. Entries remember when they were stored so that
+// callers can apply their own freshness rules.
+type LRU[K comparable, V any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[K]*list.Element
+}
+
+func NewLRU[K comparable, V any](maxEntries int) *LRU[K, V] {
+	return &LRU[K, V]{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[K]*list.Element),
+	}
+}
+
+// Get returns the value for key and the time it was stored.
+func (c *LRU[K, V]) Get(key K) (V, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, time.Time{}, false
+	}
+	c.ll.MoveToFront(el)
+	it := el.Value.(*item[K, V])
+	return it.value, it.storedAt, true
+}
+
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		it := el.Value.(*item[K, V])
+		it.value = value
+		it.storedAt = time.Now()
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&item[K, V]{key: key, value: value, storedAt: time.Now()})
+	if c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*item[K, V]).key)
+	}
+}
+
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.ll.Remove(el)
+	delete(c.items, key)
+	return true
+}
+
+// DeleteFunc removes every entry whose key satisfies match and returns how
+// many were removed.
+func (c *LRU[K, V]) DeleteFunc(match func(K) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, el := range c.items {
+		if match(key) {
+			c.ll.Remove(el)
+			delete(c.items, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
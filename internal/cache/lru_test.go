@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// op is a step of a cache test: "set k v", "get k", "del k" or "delprefix p".
+type op struct {
+	do   string
+	want string
+}
+
+func TestLRU(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxEntries int
+		ops        []op
+		wantLen    int
+	}{
+		{
+			name:       "get after set",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "get a", want: "1"},
+				{do: "get b", want: "<miss>"},
+			},
+			wantLen: 1,
+		},
+		{
+			name:       "set replaces the value",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set a 2"},
+				{do: "get a", want: "2"},
+			},
+			wantLen: 1,
+		},
+		{
+			name:       "evicts the least recently set",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set b 2"},
+				{do: "set c 3"},
+				{do: "get a", want: "<miss>"},
+				{do: "get b", want: "2"},
+				{do: "get c", want: "3"},
+			},
+			wantLen: 2,
+		},
+		{
+			name:       "get keeps an entry",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set b 2"},
+				{do: "get a", want: "1"},
+				{do: "set c 3"},
+				{do: "get a", want: "1"},
+				{do: "get b", want: "<miss>"},
+			},
+			wantLen: 2,
+		},
+		{
+			name:       "updating an entry keeps it",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set b 2"},
+				{do: "set a 3"},
+				{do: "set c 4"},
+				{do: "get a", want: "3"},
+				{do: "get b", want: "<miss>"},
+			},
+			wantLen: 2,
+		},
+		{
+			name:       "zero max entries is unbounded",
+			maxEntries: 0,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set b 2"},
+				{do: "set c 3"},
+				{do: "get a", want: "1"},
+			},
+			wantLen: 3,
+		},
+		{
+			name:       "delete",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "del a", want: "true"},
+				{do: "del a", want: "false"},
+				{do: "get a", want: "<miss>"},
+			},
+			wantLen: 0,
+		},
+		{
+			name:       "delete by prefix",
+			maxEntries: 4,
+			ops: []op{
+				{do: "set p1 1"},
+				{do: "set p2 2"},
+				{do: "set q1 3"},
+				{do: "delprefix p", want: "2"},
+				{do: "get p1", want: "<miss>"},
+				{do: "get q1", want: "3"},
+			},
+			wantLen: 1,
+		},
+		{
+			name:       "deleted entries free their slot",
+			maxEntries: 2,
+			ops: []op{
+				{do: "set a 1"},
+				{do: "set b 2"},
+				{do: "del a", want: "true"},
+				{do: "set c 3"},
+				{do: "get b", want: "2"},
+				{do: "get c", want: "3"},
+			},
+			wantLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewLRU[string, string](tt.maxEntries)
+			for _, o := range tt.ops {
+				args := strings.Fields(o.do)
+				var got string
+				switch args[0] {
+				case "set":
+					c.Set(args[1], args[2])
+					continue
+				case "get":
+					v, _, ok := c.Get(args[1])
+					got = v
+					if !ok {
+						got = "<miss>"
+					}
+				case "del":
+					got = fmt.Sprint(c.Delete(args[1]))
+				case "delprefix":
+					got = fmt.Sprint(c.DeleteFunc(func(k string) bool { return strings.HasPrefix(k, args[1]) }))
+				}
+				if got != o.want {
+					t.Fatalf("%s = %s, want %s", o.do, got, o.want)
+				}
+			}
+			if got := c.Len(); got != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestLRUStoredAt(t *testing.T) {
+	c := NewLRU[string, int](1)
+
+	before := time.Now()
+	c.Set("a", 1)
+	_, storedAt, ok := c.Get("a")
+	if !ok || storedAt.Before(before) || storedAt.After(time.Now()) {
+		t.Fatalf("Get() stored at %v, ok = %v, want a time since %v", storedAt, ok, before)
+	}
+
+	time.Sleep(time.Millisecond)
+	c.Set("a", 2)
+	if _, again, _ := c.Get("a"); !again.After(storedAt) {
+		t.Errorf("Set() of an existing key kept stored time %v", again)
+	}
+}
+
+func TestLRUConcurrent(t *testing.T) {
+	c := NewLRU[int, int](8)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Set(i%16, g)
+				c.Get((i + g) % 16)
+				if i%100 == 0 {
+					c.Delete(i % 16)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if got := c.Len(); got > 8 {
+		t.Errorf("Len() = %d, want at most 8", got)
+	}
+}
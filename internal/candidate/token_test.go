@@ -0,0 +1,114 @@
+package candidate
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIssuer(t *testing.T) {
+	issuer := NewIssuer([]byte("secret"), time.Hour)
+	token, issued, err := issuer.Issue("test-1", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	encoded, sig, _ := strings.Cut(token, ".")
+
+	expired, _, err := NewIssuer([]byte("secret"), -time.Minute).Issue("test-1", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	otherSecret, _, err := NewIssuer([]byte("other"), time.Hour).Issue("test-1", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	noTest, _, err := issuer.Issue("", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	// A payload for another test, signed with the original signature.
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(`{"tid":"test-2","email":"jane@example.com"}`)) + "." + sig
+	// A payload that is not JSON, correctly signed.
+	notJSON := base64.RawURLEncoding.EncodeToString([]byte("nope"))
+	notJSON += "." + issuer.sign(notJSON)
+
+	tests := []struct {
+		name    string
+		token   string
+		want    Claims
+		wantErr error
+	}{
+		{name: "valid", token: token, want: issued},
+		{name: "empty", token: "", wantErr: ErrInvalidToken},
+		{name: "no signature", token: encoded, wantErr: ErrInvalidToken},
+		{name: "wrong signature", token: encoded + ".AAAA", wantErr: ErrInvalidToken},
+		{name: "other secret", token: otherSecret, wantErr: ErrInvalidToken},
+		{name: "tampered claims", token: tampered, wantErr: ErrInvalidToken},
+		{name: "bad payload encoding", token: "!!!." + issuer.sign("!!!"), wantErr: ErrInvalidToken},
+		{name: "payload is not JSON", token: notJSON, wantErr: ErrInvalidToken},
+		{name: "no test ID", token: noTest, wantErr: ErrInvalidToken},
+		{name: "expired", token: expired, wantErr: ErrExpiredToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := issuer.Parse(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if !got.IssuedAt.Equal(tt.want.IssuedAt) || !got.ExpiresAt.Equal(tt.want.ExpiresAt) {
+				t.Errorf("Parse() times = %v, %v, want %v, %v", got.IssuedAt, got.ExpiresAt, tt.want.IssuedAt, tt.want.ExpiresAt)
+			}
+			if got.TestID != tt.want.TestID || got.Email != tt.want.Email {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueClaims(t *testing.T) {
+	tests := []struct {
+		name string
+		ttl  time.Duration
+	}{
+		{name: "minutes", ttl: 90 * time.Minute},
+		{name: "hours", ttl: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now().Truncate(time.Second)
+			_, claims, err := NewIssuer([]byte("secret"), tt.ttl).Issue("test-1", "jane@example.com")
+			if err != nil {
+				t.Fatalf("Issue() error = %v", err)
+			}
+			if claims.TestID != "test-1" || claims.Email != "jane@example.com" {
+				t.Errorf("Issue() claims = %+v", claims)
+			}
+			if claims.IssuedAt.Before(before) || claims.IssuedAt.After(time.Now()) {
+				t.Errorf("IssuedAt = %v, want about now", claims.IssuedAt)
+			}
+			if got := claims.ExpiresAt.Sub(claims.IssuedAt); got != tt.ttl {
+				t.Errorf("ExpiresAt - IssuedAt = %v, want %v", got, tt.ttl)
+			}
+		})
+	}
+}
+
+func TestNewIssuerRandomSecret(t *testing.T) {
+	a, b := NewIssuer(nil, time.Hour), NewIssuer(nil, time.Hour)
+
+	token, _, err := a.Issue("test-1", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if _, err := a.Parse(token); err != nil {
+		t.Errorf("Parse() with the issuing secret error = %v", err)
+	}
+	if _, err := b.Parse(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Parse() with another random secret error = %v, want %v", err, ErrInvalidToken)
+	}
+}
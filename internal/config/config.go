@@ -9,16 +9,173 @@ import (
 )
 
 type RawConfig struct {
-	ServerPort             string    `yaml:"server_port"`
-	RequestTimeout         int       `yaml:"request_timeout"`
-	ExecutorServiceAddress string    `yaml:"executor_service_address"`
-	CompanyAuthAddress     string    `yaml:"company_auth_address"`
-	Logging                LogConfig `yaml:"logging"`
+	ServerPort             string              `yaml:"server_port"`
+	RequestTimeout         int                 `yaml:"request_timeout"`
+	ExecutorServiceAddress string              `yaml:"executor_service_address"`
+	CompanyAuthAddress     string              `yaml:"company_auth_address"`
+	Logging                LogConfig           `yaml:"logging"`
+	Audit                  AuditConfig         `yaml:"audit"`
+	Idempotency            IdempotencyConfig   `yaml:"idempotency"`
+	Limits                 LimitsConfig        `yaml:"limits"`
+	HTTPCache              HTTPCacheConfig     `yaml:"http_cache"`
+	ProblemsCache          ProblemsCacheConfig `yaml:"problems_cache"`
+	TestCases              TestCasesConfig     `yaml:"test_cases"`
+	Jobs                   JobsConfig          `yaml:"jobs"`
+	Session                SessionConfig       `yaml:"session"`
+	Candidate              CandidateConfig     `yaml:"candidate"`
+	CodingTests            CodingTestsConfig   `yaml:"coding_tests"`
+	Webhooks               WebhooksConfig      `yaml:"webhooks"`
+	Admin                  AdminConfig         `yaml:"admin"`
 }
 
 type LogConfig struct {
-	Level       string `yaml:"level"`
-	Environment string `yaml:"environment"`
+	Level       string          `yaml:"level"`
+	Environment string          `yaml:"environment"`
+	Redaction   RedactionConfig `yaml:"redaction"`
+}
+
+type RedactionConfig struct {
+	Enabled  bool            `yaml:"enabled"`
+	Mode     string          `yaml:"mode"`
+	HashKey  string          `yaml:"hash_key"`
+	Fields   []string        `yaml:"fields"`
+	Patterns []PatternConfig `yaml:"patterns"`
+}
+
+type PatternConfig struct {
+	Name  string `yaml:"name"`
+	Regex string `yaml:"regex"`
+}
+
+type ProblemsCacheConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	TTLSeconds      int  `yaml:"ttl_seconds"`
+	MaxStaleSeconds int  `yaml:"max_stale_seconds"`
+	MaxEntries      int  `yaml:"max_entries"`
+}
+
+// TestCasesConfig bounds test case uploads and their reference solution runs.
+type TestCasesConfig struct {
+	ValidationTimeoutSeconds int   `yaml:"validation_timeout_seconds"`
+	MaxBulkCases             int   `yaml:"max_bulk_cases"`
+	MaxArchiveBytes          int64 `yaml:"max_archive_bytes"`
+}
+
+// JobsConfig controls how long a gateway replica remembers its callers'
+// latest jobs and batches, how long a request may wait for a job to finish
+// and how batches run.
+type JobsConfig struct {
+	TTLMinutes       int `yaml:"ttl_minutes"`
+	MaxWaitSeconds   int `yaml:"max_wait_seconds"`
+	MaxBatchItems    int `yaml:"max_batch_items"`
+	BatchConcurrency int `yaml:"batch_concurrency"`
+}
+
+// SessionConfig holds the key that signs anonymous session cookies.
+type SessionConfig struct {
+	Secret string `yaml:"secret"`
+}
+
+// CandidateConfig controls the session tokens issued to candidates when
+// they start a coding test.
+type CandidateConfig struct {
+	TokenSecret     string `yaml:"token_secret"`
+	TokenTTLMinutes int    `yaml:"token_ttl_minutes"`
+}
+
+// CodingTestsConfig controls how the gateway enforces the time limit of
+// coding tests.
+type CodingTestsConfig struct {
+	// SubmitGraceSeconds is how long after the deadline a submission is
+	// still accepted, to allow for network latency.
+	SubmitGraceSeconds int `yaml:"submit_grace_seconds"`
+	// AutoSubmit submits tests on the candidate's behalf when their time
+	// runs out.
+	AutoSubmit bool `yaml:"auto_submit"`
+	// AutoSubmitTimeoutSeconds bounds each attempt at grading and
+	// submitting a test whose time ran out.
+	AutoSubmitTimeoutSeconds int `yaml:"auto_submit_timeout_seconds"`
+	// DraftIntervalSeconds is the minimum time between two saved drafts of
+	// one problem of a test.
+	DraftIntervalSeconds int `yaml:"draft_interval_seconds"`
+	// GradeTimeoutSeconds bounds grading the code a candidate submits.
+	GradeTimeoutSeconds int `yaml:"grade_timeout_seconds"`
+}
+
+// WebhooksConfig controls job completion callbacks and company webhook
+// subscriptions.
+type WebhooksConfig struct {
+	SigningSecret         string `yaml:"signing_secret"`
+	MaxAttempts           int    `yaml:"max_attempts"`
+	InitialBackoffSeconds int    `yaml:"initial_backoff_seconds"`
+	TimeoutSeconds        int    `yaml:"timeout_seconds"`
+	// JobTimeoutSeconds bounds how long the gateway watches a job for
+	// completion before giving up on its callback.
+	JobTimeoutSeconds    int  `yaml:"job_timeout_seconds"`
+	AllowPrivateNetworks bool `yaml:"allow_private_networks"`
+	// TestEvents relays coding test lifecycle events to company webhook
+	// subscriptions.
+	TestEvents bool `yaml:"test_events"`
+	// RelayConsumer names the relay to the coding tests service, which lets
+	// one replica per name stream events and remembers its position.
+	RelayConsumer string `yaml:"relay_consumer"`
+	// QueuePath is the file deliveries are kept in until they finish, so
+	// that they are resumed after a restart.
+	QueuePath string `yaml:"queue_path"`
+}
+
+type AdminConfig struct {
+	Token string `yaml:"token"`
+}
+
+type HTTPCacheConfig struct {
+	Compression CompressionConfig  `yaml:"compression"`
+	Routes      []RouteCacheConfig `yaml:"routes"`
+}
+
+type CompressionConfig struct {
+	Enabled      bool `yaml:"enabled"`
+	MinSizeBytes int  `yaml:"min_size_bytes"`
+}
+
+type RouteCacheConfig struct {
+	Path         string `yaml:"path"`
+	CacheControl string `yaml:"cache_control"`
+}
+
+type LimitsConfig struct {
+	MaxBodyBytes int64              `yaml:"max_body_bytes"`
+	Routes       []RouteLimitConfig `yaml:"routes"`
+	Code         CodeLimitsConfig   `yaml:"code"`
+}
+
+type RouteLimitConfig struct {
+	Method       string `yaml:"method"`
+	Path         string `yaml:"path"`
+	MaxBodyBytes int64  `yaml:"max_body_bytes"`
+}
+
+type CodeLimitsConfig struct {
+	MaxBytes  int                        `yaml:"max_bytes"`
+	MaxLines  int                        `yaml:"max_lines"`
+	Languages map[string]CodeLimitConfig `yaml:"languages"`
+}
+
+type CodeLimitConfig struct {
+	MaxBytes int `yaml:"max_bytes"`
+	MaxLines int `yaml:"max_lines"`
+}
+
+type IdempotencyConfig struct {
+	TTLMinutes         int `yaml:"ttl_minutes"`
+	WaitTimeoutSeconds int `yaml:"wait_timeout_seconds"`
+}
+
+type AuditConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	FilePath   string `yaml:"file_path"`
+	MaxSizeMB  int    `yaml:"max_size_mb"`
+	MaxBackups int    `yaml:"max_backups"`
 }
 
 type Config struct {
@@ -27,6 +184,18 @@ type Config struct {
 	ExecutorServiceAddress string
 	CompanyAuthAddress     string
 	Logging                LogConfig
+	Audit                  AuditConfig
+	Idempotency            IdempotencyConfig
+	Limits                 LimitsConfig
+	HTTPCache              HTTPCacheConfig
+	ProblemsCache          ProblemsCacheConfig
+	TestCases              TestCasesConfig
+	Jobs                   JobsConfig
+	Session                SessionConfig
+	Candidate              CandidateConfig
+	CodingTests            CodingTestsConfig
+	Webhooks               WebhooksConfig
+	Admin                  AdminConfig
 }
 
 func Load() (*Config, error) {
@@ -67,6 +236,31 @@ func Load() (*Config, error) {
 	if v := os.Getenv("ENVIRONMENT"); v != "" {
 		raw.Logging.Environment = v
 	}
+	if v := os.Getenv("LOG_REDACTION_MODE"); v != "" {
+		raw.Logging.Redaction.Mode = v
+	}
+	if v := os.Getenv("LOG_REDACTION_HASH_KEY"); v != "" {
+		raw.Logging.Redaction.HashKey = v
+	}
+
+	if v := os.Getenv("ADMIN_TOKEN"); v != "" {
+		raw.Admin.Token = v
+	}
+	if v := os.Getenv("SESSION_SECRET"); v != "" {
+		raw.Session.Secret = v
+	}
+	if v := os.Getenv("CANDIDATE_TOKEN_SECRET"); v != "" {
+		raw.Candidate.TokenSecret = v
+	}
+	if v := os.Getenv("WEBHOOK_SIGNING_SECRET"); v != "" {
+		raw.Webhooks.SigningSecret = v
+	}
+	if v := os.Getenv("AUDIT_FILE_PATH"); v != "" {
+		raw.Audit.FilePath = v
+	}
+	if v := os.Getenv("WEBHOOK_QUEUE_PATH"); v != "" {
+		raw.Webhooks.QueuePath = v
+	}
 
 	if raw.Logging.Level == "" {
 		raw.Logging.Level = "info"
@@ -74,6 +268,83 @@ func Load() (*Config, error) {
 	if raw.Logging.Environment == "" {
 		raw.Logging.Environment = env
 	}
+	if raw.Idempotency.TTLMinutes <= 0 {
+		raw.Idempotency.TTLMinutes = 24 * 60
+	}
+	if raw.Idempotency.WaitTimeoutSeconds <= 0 {
+		raw.Idempotency.WaitTimeoutSeconds = 10
+	}
+	if raw.ProblemsCache.TTLSeconds <= 0 {
+		raw.ProblemsCache.TTLSeconds = 60
+	}
+	if raw.ProblemsCache.MaxEntries <= 0 {
+		raw.ProblemsCache.MaxEntries = 1000
+	}
+	if raw.Limits.MaxBodyBytes <= 0 {
+		raw.Limits.MaxBodyBytes = 1 << 20
+	}
+	if raw.TestCases.ValidationTimeoutSeconds <= 0 {
+		raw.TestCases.ValidationTimeoutSeconds = 60
+	}
+	if raw.TestCases.MaxBulkCases <= 0 {
+		raw.TestCases.MaxBulkCases = 500
+	}
+	if raw.TestCases.MaxArchiveBytes <= 0 {
+		raw.TestCases.MaxArchiveBytes = 32 << 20
+	}
+	// A random candidate token key is only acceptable locally: candidates
+	// could not resume a test after a restart, or on another replica.
+	if raw.Candidate.TokenSecret == "" && env != "local" {
+		return nil, fmt.Errorf("candidate token secret is required in %s: set CANDIDATE_TOKEN_SECRET", env)
+	}
+	if raw.Candidate.TokenTTLMinutes <= 0 {
+		raw.Candidate.TokenTTLMinutes = 30
+	}
+	if raw.CodingTests.SubmitGraceSeconds <= 0 {
+		raw.CodingTests.SubmitGraceSeconds = 30
+	}
+	if raw.CodingTests.AutoSubmitTimeoutSeconds <= 0 {
+		raw.CodingTests.AutoSubmitTimeoutSeconds = 120
+	}
+	if raw.CodingTests.DraftIntervalSeconds <= 0 {
+		raw.CodingTests.DraftIntervalSeconds = 5
+	}
+	if raw.CodingTests.GradeTimeoutSeconds <= 0 {
+		raw.CodingTests.GradeTimeoutSeconds = 60
+	}
+	if raw.Jobs.TTLMinutes <= 0 {
+		raw.Jobs.TTLMinutes = 60
+	}
+	if raw.Jobs.MaxWaitSeconds <= 0 {
+		raw.Jobs.MaxWaitSeconds = 10
+	}
+	if raw.Jobs.MaxBatchItems <= 0 {
+		raw.Jobs.MaxBatchItems = 200
+	}
+	if raw.Jobs.BatchConcurrency <= 0 {
+		raw.Jobs.BatchConcurrency = 8
+	}
+	if raw.Webhooks.MaxAttempts <= 0 {
+		raw.Webhooks.MaxAttempts = 5
+	}
+	if raw.Webhooks.InitialBackoffSeconds <= 0 {
+		raw.Webhooks.InitialBackoffSeconds = 2
+	}
+	if raw.Webhooks.TimeoutSeconds <= 0 {
+		raw.Webhooks.TimeoutSeconds = 10
+	}
+	if raw.Webhooks.JobTimeoutSeconds <= 0 {
+		raw.Webhooks.JobTimeoutSeconds = 600
+	}
+	if raw.Webhooks.RelayConsumer == "" {
+		raw.Webhooks.RelayConsumer = "api-gateway"
+	}
+	if raw.Webhooks.QueuePath == "" {
+		raw.Webhooks.QueuePath = filepath.Join("data", "webhook-queue.jsonl")
+	}
+	if raw.Audit.FilePath == "" {
+		raw.Audit.FilePath = filepath.Join("logs", "audit.jsonl")
+	}
 
 	return &Config{
 		ServerPort:             raw.ServerPort,
@@ -81,5 +352,17 @@ func Load() (*Config, error) {
 		ExecutorServiceAddress: raw.ExecutorServiceAddress,
 		CompanyAuthAddress:     raw.CompanyAuthAddress,
 		Logging:                raw.Logging,
+		Audit:                  raw.Audit,
+		Idempotency:            raw.Idempotency,
+		Limits:                 raw.Limits,
+		HTTPCache:              raw.HTTPCache,
+		ProblemsCache:          raw.ProblemsCache,
+		TestCases:              raw.TestCases,
+		Jobs:                   raw.Jobs,
+		Session:                raw.Session,
+		Candidate:              raw.Candidate,
+		CodingTests:            raw.CodingTests,
+		Webhooks:               raw.Webhooks,
+		Admin:                  raw.Admin,
 	}, nil
 }
@@ -0,0 +1,104 @@
+package diff
+
+import (
+	"strings"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+const (
+	OpEqual  = " "
+	OpDelete = "-"
+	OpInsert = "+"
+)
+
+// maxCells bounds the LCS table. Texts with more changed lines than that are
+// shown as a full replacement instead.
+const maxCells = 4 << 20
+
+// Lines returns a line diff turning a into b. Common leading and trailing
+// lines are matched first; the rest is diffed by longest common subsequence.
+func Lines(a, b string) []model.DiffLine {
+	if a == b {
+		return nil
+	}
+
+	x, y := splitLines(a), splitLines(b)
+
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]model.DiffLine, 0, len(x)+len(y))
+	for _, l := range x[:prefix] {
+		out = append(out, model.DiffLine{Op: OpEqual, Text: l})
+	}
+	out = append(out, middle(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for _, l := range x[len(x)-suffix:] {
+		out = append(out, model.DiffLine{Op: OpEqual, Text: l})
+	}
+	return out
+}
+
+func middle(x, y []string) []model.DiffLine {
+	var out []model.DiffLine
+	if len(x)*len(y) > maxCells {
+		for _, l := range x {
+			out = append(out, model.DiffLine{Op: OpDelete, Text: l})
+		}
+		for _, l := range y {
+			out = append(out, model.DiffLine{Op: OpInsert, Text: l})
+		}
+		return out
+	}
+
+	// lcs[i][j] is the LCS length of x[i:] and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			out = append(out, model.DiffLine{Op: OpEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, model.DiffLine{Op: OpDelete, Text: x[i]})
+			i++
+		default:
+			out = append(out, model.DiffLine{Op: OpInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		out = append(out, model.DiffLine{Op: OpDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		out = append(out, model.DiffLine{Op: OpInsert, Text: y[j]})
+	}
+	return out
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,121 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []string
+	}{
+		{name: "equal", a: "a\nb\n", b: "a\nb\n", want: nil},
+		{name: "both empty", a: "", b: "", want: nil},
+		{name: "from empty", a: "", b: "a\nb", want: []string{"+a", "+b"}},
+		{name: "to empty", a: "a\nb", b: "", want: []string{"-a", "-b"}},
+		{name: "changed line", a: "a\nb\nc", b: "a\nx\nc", want: []string{" a", "-b", "+x", " c"}},
+		{name: "inserted line", a: "a\nc", b: "a\nb\nc", want: []string{" a", "+b", " c"}},
+		{name: "deleted line", a: "a\nb\nc", b: "a\nc", want: []string{" a", "-b", " c"}},
+		{name: "appended line", a: "a\nb\n", b: "a\nb\nc\n", want: []string{" a", " b", "+c"}},
+		{
+			name: "common lines inside the change",
+			a:    "head\n1\nkeep\n2\ntail",
+			b:    "head\n3\nkeep\n4\ntail",
+			want: []string{" head", "-1", "+3", " keep", "-2", "+4", " tail"},
+		},
+		{
+			name: "moved line",
+			a:    "a\nb\nc",
+			b:    "b\nc\na",
+			want: []string{"-a", " b", " c", "+a"},
+		},
+		{
+			name: "repeated lines match the common suffix",
+			a:    "x\nx\ny",
+			b:    "x\ny\ny",
+			want: []string{" x", "-x", "+y", " y"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := format(Lines(tt.a, tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinesApplies(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "rewrite", a: "func main() {\n\tprintln(1)\n}\n", b: "package main\n\nfunc main() {\n\tprintln(2)\n}\n"},
+		{name: "interleaved", a: "1\n2\n3\n4\n5\n6", b: "0\n2\n3\n7\n5\n8"},
+		{name: "disjoint", a: "a\nb\nc", b: "d\ne"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var old, updated []string
+			for _, l := range Lines(tt.a, tt.b) {
+				if l.Op != OpInsert {
+					old = append(old, l.Text)
+				}
+				if l.Op != OpDelete {
+					updated = append(updated, l.Text)
+				}
+			}
+			if got := strings.Join(old, "\n"); got != strings.TrimSuffix(tt.a, "\n") {
+				t.Errorf("old side = %q, want %q", got, tt.a)
+			}
+			if got := strings.Join(updated, "\n"); got != strings.TrimSuffix(tt.b, "\n") {
+				t.Errorf("new side = %q, want %q", got, tt.b)
+			}
+		})
+	}
+}
+
+func TestLinesTooLarge(t *testing.T) {
+	// Two texts without common lines whose LCS table would exceed maxCells
+	// are shown as a full replacement.
+	n := 2100
+	a := make([]string, n)
+	b := make([]string, n)
+	for i := range a {
+		a[i] = "a" + strings.Repeat("x", i%7) + string(rune('0'+i%10))
+		b[i] = "b" + string(rune('0'+i%10))
+	}
+	got := Lines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+
+	if len(got) != 2*n {
+		t.Fatalf("got %d lines, want %d", len(got), 2*n)
+	}
+	for i, l := range got {
+		want := OpDelete
+		if i >= n {
+			want = OpInsert
+		}
+		if l.Op != want {
+			t.Fatalf("line %d has op %q, want %q", i, l.Op, want)
+		}
+	}
+}
+
+func format(lines []model.DiffLine) []string {
+	if lines == nil {
+		return nil
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		out[i] = l.Op + l.Text
+	}
+	return out
+}
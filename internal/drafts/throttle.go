@@ -0,0 +1,73 @@
+// Package drafts limits how often the drafts candidates autosave during a
+// test are written to the coding tests service.
+package drafts
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle allows one draft write per problem of a test every interval.
+type Throttle struct {
+	mu        sync.Mutex
+	last      map[key]time.Time
+	interval  time.Duration
+	lastSweep time.Time
+}
+
+// key identifies the drafts of one problem of a test. problemID is zero for
+// single-problem tests.
+type key struct {
+	testID    string
+	problemID int32
+}
+
+func NewThrottle(interval time.Duration) *Throttle {
+	return &Throttle{
+		last:     make(map[key]time.Time),
+		interval: interval,
+	}
+}
+
+// Allow reports whether a draft of a test's problem may be written now, and
+// records the write if so. Otherwise it returns how long to wait. Drafts of
+// different problems of an assessment do not hold each other up.
+func (t *Throttle) Allow(testID string, problemID int32) (time.Duration, bool) {
+	k := key{testID: testID, problemID: problemID}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.sweep(now)
+
+	if last, ok := t.last[k]; ok {
+		if wait := t.interval - now.Sub(last); wait > 0 {
+			return wait, false
+		}
+	}
+	t.last[k] = now
+	return 0, true
+}
+
+// Forget drops the write recorded for a test's problem, e.g. because it
+// failed.
+func (t *Throttle) Forget(testID string, problemID int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.last, key{testID: testID, problemID: problemID})
+}
+
+// sweep drops writes older than the interval. Callers must hold t.mu.
+func (t *Throttle) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < t.interval {
+		return
+	}
+	t.lastSweep = now
+
+	for k, last := range t.last {
+		if now.Sub(last) >= t.interval {
+			delete(t.last, k)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package drafts
+
+import (
+	"testing"
+	"time"
+)
+
+const interval = 50 * time.Millisecond
+
+// call is a step of a throttle test: after sleeping for after, a draft of
+// the test's problem is saved, or forgotten when forget is set.
+type call struct {
+	after     time.Duration
+	testID    string
+	problemID int32
+	forget    bool
+	want      bool
+}
+
+func TestThrottle(t *testing.T) {
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "first draft",
+			calls: []call{
+				{testID: "a", want: true},
+			},
+		},
+		{
+			name: "second draft within the interval",
+			calls: []call{
+				{testID: "a", want: true},
+				{testID: "a", want: false},
+			},
+		},
+		{
+			name: "second draft after the interval",
+			calls: []call{
+				{testID: "a", want: true},
+				{after: interval, testID: "a", want: true},
+			},
+		},
+		{
+			name: "other tests are independent",
+			calls: []call{
+				{testID: "a", want: true},
+				{testID: "b", want: true},
+				{testID: "a", want: false},
+			},
+		},
+		{
+			name: "problems of an assessment are independent",
+			calls: []call{
+				{testID: "a", problemID: 1, want: true},
+				{testID: "a", problemID: 2, want: true},
+				{testID: "a", problemID: 0, want: true},
+				{testID: "a", problemID: 1, want: false},
+			},
+		},
+		{
+			name: "rejected drafts do not extend the wait",
+			calls: []call{
+				{testID: "a", want: true},
+				{after: interval / 2, testID: "a", want: false},
+				{after: interval / 2, testID: "a", want: true},
+			},
+		},
+		{
+			name: "forget allows the next draft",
+			calls: []call{
+				{testID: "a", want: true},
+				{testID: "a", forget: true},
+				{testID: "a", want: true},
+				{testID: "a", want: false},
+			},
+		},
+		{
+			name: "forget only drops its own problem",
+			calls: []call{
+				{testID: "a", problemID: 1, want: true},
+				{testID: "a", problemID: 2, want: true},
+				{testID: "a", problemID: 1, forget: true},
+				{testID: "a", problemID: 1, want: true},
+				{testID: "a", problemID: 2, want: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle := NewThrottle(interval)
+			for i, c := range tt.calls {
+				time.Sleep(c.after)
+				if c.forget {
+					throttle.Forget(c.testID, c.problemID)
+					continue
+				}
+				wait, ok := throttle.Allow(c.testID, c.problemID)
+				if ok != c.want {
+					t.Fatalf("call %d: Allow(%q, %d) = %v, want %v", i, c.testID, c.problemID, ok, c.want)
+				}
+				if ok && wait != 0 {
+					t.Errorf("call %d: allowed with wait %v", i, wait)
+				}
+				if !ok && (wait <= 0 || wait > interval) {
+					t.Errorf("call %d: wait = %v, want within (0, %v]", i, wait, interval)
+				}
+			}
+		})
+	}
+}
+
+func TestThrottleSweep(t *testing.T) {
+	throttle := NewThrottle(interval)
+	for _, id := range []string{"a", "b", "c"} {
+		throttle.Allow(id, 0)
+	}
+
+	time.Sleep(interval)
+	throttle.Allow("d", 0)
+
+	throttle.mu.Lock()
+	defer throttle.mu.Unlock()
+	if len(throttle.last) != 1 {
+		t.Errorf("%d writes are remembered after the interval, want 1", len(throttle.last))
+	}
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+)
+
+// MakePurgeProblemCacheHandler creates a handler that drops cached entries for
+// one problem
+func MakePurgeProblemCacheHandler(cachedProblems *problems.CachedClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.PurgeCacheResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, model.PurgeCacheResponse{
+			Success: true,
+			Purged:  cachedProblems.PurgeProblem(int32(id)),
+		})
+	}
+}
+
+// MakePurgeAllProblemsCacheHandler creates a handler that empties the problems cache
+func MakePurgeAllProblemsCacheHandler(cachedProblems *problems.CachedClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, model.PurgeCacheResponse{
+			Success: true,
+			Purged:  cachedProblems.PurgeAll(),
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AssessmentTemplateHandler manages a company's assessment templates, the
+// reusable settings tests are generated from.
+type AssessmentTemplateHandler struct {
+	client   *coding_tests.Client
+	problems problems.Service
+	audit    *audit.Recorder
+}
+
+func NewAssessmentTemplateHandler(client *coding_tests.Client, problemsClient problems.Service, auditor *audit.Recorder) *AssessmentTemplateHandler {
+	return &AssessmentTemplateHandler{
+		client:   client,
+		problems: problemsClient,
+		audit:    auditor,
+	}
+}
+
+// Create stores a new template for the authenticated company.
+func (h *AssessmentTemplateHandler) Create(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	tmpl, ok := h.bindTemplate(c, companyID)
+	if !ok {
+		return
+	}
+	tmpl.CompanyId = int32(companyID)
+
+	resp, err := h.client.CreateAssessmentTemplate(c.Request.Context(), tmpl)
+	if err != nil {
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionTemplateCreate,
+			Outcome:   audit.OutcomeError,
+			Details:   map[string]string{"name": tmpl.Name},
+		})
+		writeTemplateError(c, "Failed to create template", err)
+		return
+	}
+
+	created := coding_tests.ToAssessmentTemplate(resp.Template)
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionTemplateCreate,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   map[string]string{"template_id": strconv.Itoa(created.ID), "name": created.Name},
+	})
+
+	c.JSON(http.StatusCreated, model.AssessmentTemplateResponse{
+		Success:  true,
+		Template: &created,
+	})
+}
+
+// List returns the company's templates ordered by name.
+func (h *AssessmentTemplateHandler) List(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	resp, err := h.client.ListAssessmentTemplates(c.Request.Context(), int32(companyID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.ListAssessmentTemplatesResponse{
+			Success: false,
+			Error:   "Failed to list templates: " + err.Error(),
+		})
+		return
+	}
+
+	templates := make([]model.AssessmentTemplate, len(resp.Templates))
+	for i, t := range resp.Templates {
+		templates[i] = coding_tests.ToAssessmentTemplate(t)
+	}
+	c.JSON(http.StatusOK, model.ListAssessmentTemplatesResponse{
+		Success:   true,
+		Templates: templates,
+	})
+}
+
+// Get returns one of the company's templates.
+func (h *AssessmentTemplateHandler) Get(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	id, ok := templateIDParam(c)
+	if !ok {
+		return
+	}
+
+	tmpl, ok := loadTemplate(c, h.client, companyID, id)
+	if !ok {
+		return
+	}
+
+	t := coding_tests.ToAssessmentTemplate(tmpl)
+	c.JSON(http.StatusOK, model.AssessmentTemplateResponse{
+		Success:  true,
+		Template: &t,
+	})
+}
+
+// Update replaces every field of a template. Tests already generated from
+// it keep their settings.
+func (h *AssessmentTemplateHandler) Update(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	id, ok := templateIDParam(c)
+	if !ok {
+		return
+	}
+
+	tmpl, ok := h.bindTemplate(c, companyID)
+	if !ok {
+		return
+	}
+	tmpl.Id = int32(id)
+	tmpl.CompanyId = int32(companyID)
+
+	details := map[string]string{"template_id": strconv.Itoa(id)}
+	resp, err := h.client.UpdateAssessmentTemplate(c.Request.Context(), tmpl)
+	if err != nil {
+		outcome := audit.OutcomeError
+		if status.Code(err) == codes.NotFound {
+			outcome = audit.OutcomeFailure
+		}
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionTemplateUpdate,
+			Outcome:   outcome,
+			Details:   details,
+		})
+		writeTemplateError(c, "Failed to update template", err)
+		return
+	}
+
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionTemplateUpdate,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   details,
+	})
+
+	updated := coding_tests.ToAssessmentTemplate(resp.Template)
+	c.JSON(http.StatusOK, model.AssessmentTemplateResponse{
+		Success:  true,
+		Template: &updated,
+	})
+}
+
+// Delete removes a template. Tests generated from it are not affected.
+func (h *AssessmentTemplateHandler) Delete(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	id, ok := templateIDParam(c)
+	if !ok {
+		return
+	}
+
+	details := map[string]string{"template_id": strconv.Itoa(id)}
+	if _, err := h.client.DeleteAssessmentTemplate(c.Request.Context(), int32(id), int32(companyID)); err != nil {
+		if status.Code(err) == codes.NotFound {
+			h.audit.Record(c, audit.Event{
+				CompanyID: companyID,
+				Action:    audit.ActionTemplateDelete,
+				Outcome:   audit.OutcomeFailure,
+				Details:   details,
+			})
+			c.JSON(http.StatusNotFound, model.DeleteAssessmentTemplateResponse{
+				Success: false,
+				Error:   "Template not found",
+			})
+			return
+		}
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionTemplateDelete,
+			Outcome:   audit.OutcomeError,
+			Details:   details,
+		})
+		c.JSON(http.StatusInternalServerError, model.DeleteAssessmentTemplateResponse{
+			Success: false,
+			Error:   "Failed to delete template: " + err.Error(),
+		})
+		return
+	}
+
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionTemplateDelete,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   details,
+	})
+	c.JSON(http.StatusOK, model.DeleteAssessmentTemplateResponse{
+		Success: true,
+		Message: "Template deleted",
+	})
+}
+
+// bindTemplate reads a template from the request body and checks the company
+// may use its problems. On failure it writes the response and returns false.
+func (h *AssessmentTemplateHandler) bindTemplate(c *gin.Context, companyID int) (*codingtestspb.AssessmentTemplate, bool) {
+	var req model.AssessmentTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, model.AssessmentTemplateResponse{
+			Success: false,
+			Error:   "Invalid request payload: " + err.Error(),
+		})
+		return nil, false
+	}
+
+	problemList, err := assessmentProblems(req.Problems)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, model.AssessmentTemplateResponse{
+			Success: false,
+			Error:   "Invalid request payload: " + err.Error(),
+		})
+		return nil, false
+	}
+	ids := make([]int32, len(problemList))
+	for i, p := range problemList {
+		ids[i] = p.ProblemId
+	}
+	if !checkProblemsUsable(c, h.problems, companyID, ids) {
+		return nil, false
+	}
+
+	return &codingtestspb.AssessmentTemplate{
+		Name:                req.Name,
+		Problems:            problemList,
+		TestDurationMinutes: int32(req.TestDurationMinutes),
+		ExpiresInHours:      int32(req.ExpiresInHours),
+		AllowedLanguages:    req.AllowedLanguages,
+		Instructions:        req.Instructions,
+		PassingThreshold:    int32(req.PassingThreshold),
+	}, true
+}
+
+func templateIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("template_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, model.AssessmentTemplateResponse{
+			Success: false,
+			Error:   "Invalid template ID: " + err.Error(),
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// loadTemplate fetches one of a company's templates. On failure it writes
+// the response and returns false; templates of other companies are
+// reported as missing.
+func loadTemplate(c *gin.Context, client *coding_tests.Client, companyID, templateID int) (*codingtestspb.AssessmentTemplate, bool) {
+	resp, err := client.GetAssessmentTemplate(c.Request.Context(), int32(templateID), int32(companyID))
+	if err != nil {
+		writeTemplateError(c, "Failed to get template", err)
+		return nil, false
+	}
+	return resp.Template, true
+}
+
+// writeTemplateError responds to a failed template call, passing on the
+// service's verdict for templates that are missing, invalid or clash with
+// another template's name.
+func writeTemplateError(c *gin.Context, message string, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Template not found",
+		})
+		return
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.AlreadyExists:
+		code = http.StatusConflict
+	}
+	c.JSON(code, gin.H{
+		"success": false,
+		"error":   message + ": " + status.Convert(err).Message(),
+	})
+}
+
+// templateTestOptions returns the options of a test generated from tmpl.
+// A non-zero expiresInHours overrides the template's default.
+func templateTestOptions(tmpl *codingtestspb.AssessmentTemplate, expiresInHours int) coding_tests.GenerateTestOptions {
+	opts := coding_tests.GenerateTestOptions{
+		ExpiresInHours:      tmpl.ExpiresInHours,
+		TestDurationMinutes: tmpl.TestDurationMinutes,
+		TemplateID:          tmpl.Id,
+		AllowedLanguages:    tmpl.AllowedLanguages,
+		Instructions:        tmpl.Instructions,
+		PassingThreshold:    tmpl.PassingThreshold,
+	}
+	if expiresInHours > 0 {
+		opts.ExpiresInHours = int32(expiresInHours)
+	}
+
+	// A template with one problem generates an ordinary single-problem test
+	if len(tmpl.Problems) == 1 {
+		opts.ProblemID = tmpl.Problems[0].ProblemId
+		opts.ProblemVersion = tmpl.Problems[0].ProblemVersion
+	} else {
+		opts.Problems = tmpl.Problems
+	}
+	return opts
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+)
+
+// testProblem returns the problem of test with the given ID, or its first
+// problem when problemID is zero. On failure it writes the response and
+// returns nil.
+func testProblem(c *gin.Context, test *codingtestspb.CodingTest, problemID int) *codingtestspb.AssessmentProblem {
+	if problemID == 0 {
+		return coding_tests.Problems(test)[0]
+	}
+	p, ok := coding_tests.Problem(test, int32(problemID))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Problem is not part of this test",
+		})
+		return nil
+	}
+	return p
+}
+
+// assessmentProblems converts the problems of an assessment, defaulting
+// their weight to 1. A problem may only be listed once.
+func assessmentProblems(problems []model.AssessmentProblem) ([]*codingtestspb.AssessmentProblem, error) {
+	out := make([]*codingtestspb.AssessmentProblem, 0, len(problems))
+	seen := make(map[int]bool, len(problems))
+	for _, p := range problems {
+		if seen[p.ProblemID] {
+			return nil, fmt.Errorf("duplicate problem %d", p.ProblemID)
+		}
+		seen[p.ProblemID] = true
+		out = append(out, &codingtestspb.AssessmentProblem{
+			ProblemId:      int32(p.ProblemID),
+			ProblemVersion: int32(p.ProblemVersion),
+			Weight:         int32(max(p.Weight, 1)),
+		})
+	}
+	return out, nil
+}
+
+// checkLanguageAllowed reports whether a test lets the candidate use
+// language. If not, it writes the response.
+func checkLanguageAllowed(c *gin.Context, test *codingtestspb.CodingTest, language string) bool {
+	if len(test.AllowedLanguages) == 0 || slices.Contains(test.AllowedLanguages, language) {
+		return true
+	}
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error":   "Language " + language + " is not allowed in this test",
+	})
+	return false
+}
+
+// problemIDQuery parses the optional problem_id query parameter, which
+// selects a problem of a multi-problem assessment. On failure it writes the
+// response and returns false.
+func problemIDQuery(c *gin.Context) (int, bool) {
+	v := c.Query("problem_id")
+	if v == "" {
+		return 0, true
+	}
+	problemID, err := strconv.Atoi(v)
+	if err != nil || problemID < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid problem ID: " + v,
+		})
+		return 0, false
+	}
+	return problemID, true
+}
+
+// MakeSubmitProblemHandler creates a handler for submitting the candidate's
+// solution to one problem of a multi-problem assessment. A later submission
+// for the same problem replaces the earlier one; the test itself stays open
+// until it is submitted or its time runs out. The code is graded against the
+// problem's pinned version before it is stored
+func MakeSubmitProblemHandler(codingTestsClient *coding_tests.Client, executorClient *executor.Client, problemsClient problems.Service, codeValidator *limits.CodeValidator, grace, gradeTimeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, err := strconv.Atoi(c.Param("problem_id"))
+		if err != nil || problemID < 1 {
+			c.JSON(http.StatusBadRequest, model.SubmitProblemResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + c.Param("problem_id"),
+			})
+			return
+		}
+
+		var req model.SubmitProblemRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SubmitProblemResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
+		}
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !coding_tests.IsAssessment(test) {
+			c.JSON(http.StatusConflict, model.SubmitProblemResponse{
+				Success: false,
+				Error:   "Test has a single problem; submit the test instead",
+			})
+			return
+		}
+		problem := testProblem(c, test, problemID)
+		if problem == nil {
+			return
+		}
+		if !checkLanguageAllowed(c, test, req.Language) {
+			return
+		}
+		if !checkTestOpen(c, test, grace) {
+			return
+		}
+
+		passed, ok := gradeSubmission(c, executorClient, problemsClient, problem, req.Language, req.Code, req.Mode, gradeTimeout)
+		if !ok {
+			return
+		}
+
+		resp, err := codingTestsClient.SubmitProblem(c.Request.Context(), test.Id, int32(problemID), req.Language, req.Code, int32(passed))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.SubmitProblemResponse{
+				Success: false,
+				Error:   "Failed to submit problem: " + err.Error(),
+			})
+			return
+		}
+
+		submissions := coding_tests.WithSubmission(test.Submissions, resp.Submission)
+		submission := coding_tests.ToProblemSubmission(resp.Submission)
+		c.JSON(http.StatusOK, model.SubmitProblemResponse{
+			Success:    true,
+			Submission: &submission,
+			TotalScore: coding_tests.WeightedScore(test, submissions),
+		})
+	}
+}
+
+// gradeSubmission grades submitted code against the pinned version of a
+// test's problem and returns the weighted percentage of test cases passed.
+// On failure it writes the response and returns false.
+func gradeSubmission(c *gin.Context, executorClient *executor.Client, problemsClient problems.Service, problem *codingtestspb.AssessmentProblem, language, code, mode string, timeout time.Duration) (int, bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	weights, err := problems.TestCaseWeights(ctx, problemsClient, problem.ProblemId, problem.ProblemVersion)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to get test cases: " + err.Error(),
+		})
+		return 0, false
+	}
+
+	passed, err := executorClient.Grade(ctx, language, code, executor.ExecuteOptions{
+		ProblemID:      int(problem.ProblemId),
+		ProblemVersion: int(problem.ProblemVersion),
+		Harness:        mode == model.ExecutionModeHarness,
+	}, weights)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to grade submission: " + err.Error(),
+		})
+		return 0, false
+	}
+	return passed, true
+}
+
+// draftProblemID returns the problem ID that drafts and timer snapshots of p
+// are recorded under: zero for single-problem tests, whose drafts are not
+// split by problem.
+func draftProblemID(test *codingtestspb.CodingTest, p *codingtestspb.AssessmentProblem) int32 {
+	if !coding_tests.IsAssessment(test) {
+		return 0
+	}
+	return p.ProblemId
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// MakeListAuditEventsHandler creates a handler that lets an authenticated
+// company review its own audit events
+func MakeListAuditEventsHandler(auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, ok := middleware.CompanyIDFromContext(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, model.ListAuditEventsResponse{
+				Success: false,
+				Error:   "Company authentication required",
+			})
+			return
+		}
+
+		filter := audit.Filter{
+			CompanyID: companyID,
+			Action:    c.Query("action"),
+			Limit:     defaultAuditLimit,
+		}
+
+		if v := c.Query("limit"); v != "" {
+			limit, err := strconv.Atoi(v)
+			if err != nil || limit <= 0 {
+				c.JSON(http.StatusBadRequest, model.ListAuditEventsResponse{
+					Success: false,
+					Error:   "Invalid limit",
+				})
+				return
+			}
+			filter.Limit = min(limit, maxAuditLimit)
+		}
+
+		for param, dst := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+			v := c.Query(param)
+			if v == "" {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, model.ListAuditEventsResponse{
+					Success: false,
+					Error:   "Invalid " + param + " timestamp, expected RFC3339: " + err.Error(),
+				})
+				return
+			}
+			*dst = t
+		}
+
+		events, err := auditor.Query(c.Request.Context(), filter)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.ListAuditEventsResponse{
+				Success: false,
+				Error:   "Failed to query audit events: " + err.Error(),
+			})
+			return
+		}
+
+		response := make([]model.AuditEvent, len(events))
+		for i, e := range events {
+			response[i] = model.AuditEvent{
+				ID:            e.ID,
+				Time:          e.Time,
+				CompanyID:     e.CompanyID,
+				Actor:         e.Actor,
+				Action:        e.Action,
+				Outcome:       e.Outcome,
+				ClientIP:      e.ClientIP,
+				RequestID:     e.RequestID,
+				CorrelationID: e.CorrelationID,
+				Details:       e.Details,
+			}
+		}
+
+		c.JSON(http.StatusOK, model.ListAuditEventsResponse{
+			Success: true,
+			Events:  response,
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MakeBatchExecuteHandler creates a handler that submits many executions at
+// once, at most concurrency at a time. Items that are invalid or fail to
+// submit are reported individually; the rest still run. Items for private
+// problems of other companies are rejected like missing problems.
+func MakeBatchExecuteHandler(executorClient *executor.Client, problemsClient problems.Service, codeValidator *limits.CodeValidator, registry *jobs.Registry, auditor *audit.Recorder, maxItems, concurrency int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, _ := middleware.CompanyIDFromContext(c)
+
+		var req model.BatchExecuteRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.BatchExecuteResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+		if len(req.Items) > maxItems {
+			c.JSON(http.StatusBadRequest, model.BatchExecuteResponse{
+				Success: false,
+				Error:   "Too many items: at most " + strconv.Itoa(maxItems) + " per batch",
+			})
+			return
+		}
+
+		batchID, err := jobs.NewBatchID()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.BatchExecuteResponse{
+				Success: false,
+				Error:   "Failed to create batch: " + err.Error(),
+			})
+			return
+		}
+
+		owner := jobOwner(c)
+		batch := &jobs.Batch{
+			ID:        batchID,
+			Owner:     owner,
+			CreatedAt: time.Now(),
+			Items:     make([]jobs.BatchItem, len(req.Items)),
+		}
+
+		// Batches usually re-grade many submissions of a few problems
+		problemErrors := make(map[int]string)
+		checkProblem := func(problemID int) string {
+			msg, ok := problemErrors[problemID]
+			if !ok {
+				msg = batchProblemError(c, problemsClient, companyID, problemID)
+				problemErrors[problemID] = msg
+			}
+			return msg
+		}
+
+		var g errgroup.Group
+		g.SetLimit(concurrency)
+		for i, item := range req.Items {
+			if msg := validateBatchItem(codeValidator, item, checkProblem); msg != "" {
+				batch.Items[i].Error = msg
+				continue
+			}
+			g.Go(func() error {
+				resp, err := executorClient.Execute(c.Request.Context(), item.Language, item.Code, executor.ExecuteOptions{
+					ProblemID:      item.ProblemID,
+					ProblemVersion: item.ProblemVersion,
+					Harness:        item.Mode == model.ExecutionModeHarness,
+					Owner:          owner,
+				})
+				switch {
+				case err != nil:
+					batch.Items[i].Error = "Failed to execute: " + err.Error()
+				case !resp.Success:
+					batch.Items[i].Error = resp.Error
+				default:
+					batch.Items[i].JobID = resp.JobId
+				}
+				return nil
+			})
+		}
+		_ = g.Wait()
+
+		resp := model.BatchExecuteResponse{
+			Success: true,
+			BatchID: batchID,
+			Items:   make([]model.BatchItemStatus, len(batch.Items)),
+		}
+		for i, item := range batch.Items {
+			resp.Items[i] = model.BatchItemStatus{Index: i, JobID: item.JobID, Error: item.Error}
+			if item.JobID == "" {
+				resp.Items[i].Status = model.BatchItemSubmitFailed
+				resp.Failed++
+				continue
+			}
+			resp.Items[i].Status = executor.JobStatusPending
+			resp.Submitted++
+		}
+		registry.TrackBatch(batch)
+
+		outcome := audit.OutcomeSuccess
+		if resp.Submitted == 0 {
+			outcome = audit.OutcomeError
+		}
+		auditor.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionExecuteBatch,
+			Outcome:   outcome,
+			Details: map[string]string{
+				"batch_id":  batchID,
+				"submitted": strconv.Itoa(resp.Submitted),
+				"failed":    strconv.Itoa(resp.Failed),
+			},
+		})
+
+		c.JSON(http.StatusAccepted, resp)
+	}
+}
+
+// MakeBatchStatusHandler creates a handler that reports the state of every
+// job in a batch along with per-status counts. Only the company that
+// submitted the batch may read it.
+func MakeBatchStatusHandler(executorClient *executor.Client, registry *jobs.Registry, concurrency int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		batchID := c.Param("batch_id")
+
+		batch, ok := registry.Batch(batchID)
+		if !ok || !isOwner(c, batch.Owner) {
+			c.JSON(http.StatusNotFound, model.BatchStatusResponse{
+				Success: false,
+				BatchID: batchID,
+				Error:   "Batch not found",
+			})
+			return
+		}
+
+		items := make([]model.BatchItemStatus, len(batch.Items))
+		var g errgroup.Group
+		g.SetLimit(concurrency)
+		for i, item := range batch.Items {
+			items[i] = model.BatchItemStatus{Index: i, JobID: item.JobID, Error: item.Error}
+			if item.JobID == "" {
+				items[i].Status = model.BatchItemSubmitFailed
+				continue
+			}
+			g.Go(func() error {
+				job, err := executorClient.GetJobStatus(c.Request.Context(), item.JobID)
+				switch {
+				case err != nil:
+					items[i].Error = "Failed to get job status: " + err.Error()
+				case !job.Success:
+					items[i].Error = job.Error
+				default:
+					if executor.IsTerminal(job.Status) {
+						registry.Finish(item.JobID)
+					}
+					items[i].Status = job.Status
+					items[i].Verdict = executor.VerdictCode(job.Verdict)
+					items[i].Error = job.Error
+					items[i].TotalCount = len(job.TestResults)
+					for _, tr := range job.TestResults {
+						if tr.Passed {
+							items[i].PassedCount++
+						}
+					}
+				}
+				return nil
+			})
+		}
+		_ = g.Wait()
+
+		resp := model.BatchStatusResponse{
+			Success: true,
+			BatchID: batchID,
+			Status:  model.BatchStatusCompleted,
+			Counts:  make(map[string]int),
+			Items:   items,
+		}
+		for _, item := range items {
+			resp.Counts[item.Status]++
+			switch {
+			case item.Status == model.BatchItemSubmitFailed,
+				item.Status == executor.JobStatusFailed,
+				item.Status == executor.JobStatusCancelled:
+				if resp.Status != model.BatchStatusRunning {
+					resp.Status = model.BatchStatusPartialFailure
+				}
+			case !executor.IsTerminal(item.Status):
+				// Includes items whose status could not be read.
+				resp.Status = model.BatchStatusRunning
+			}
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// validateBatchItem returns why item cannot be submitted, or "".
+func validateBatchItem(codeValidator *limits.CodeValidator, item model.BatchExecuteItem, checkProblem func(problemID int) string) string {
+	if item.Language != "go" {
+		return "Unsupported language. Only 'go' is supported."
+	}
+	if violation := codeValidator.Validate(item.Language, item.Code); violation != nil {
+		return violation.Message
+	}
+	return checkProblem(item.ProblemID)
+}
+
+// batchProblemError returns why a company may not run code against a
+// problem, or "" if it may.
+func batchProblemError(c *gin.Context, problemsClient problems.Service, companyID, problemID int) string {
+	resp, err := problemsClient.GetProblem(c.Request.Context(), int32(problemID))
+	if err != nil && status.Code(err) != codes.NotFound {
+		return "Failed to get problem: " + err.Error()
+	}
+	if err != nil || !problemVisibleTo(resp.Problem, int32(companyID)) {
+		return "Problem not found"
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/candidate"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+)
+
+// isTestCandidate reports whether the request carries the token of the
+// candidate who started test.
+func isTestCandidate(c *gin.Context, test *codingtestspb.CodingTest) bool {
+	claims, ok := middleware.CandidateFromContext(c)
+	return ok && claims.TestID == test.Id && strings.EqualFold(claims.Email, test.CandidateEmail)
+}
+
+// checkCandidate requires the token of the candidate who started test. On
+// failure it writes the response and returns false.
+func checkCandidate(c *gin.Context, test *codingtestspb.CodingTest) bool {
+	if _, ok := middleware.CandidateFromContext(c); !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "Missing candidate token",
+		})
+		return false
+	}
+	if !isTestCandidate(c, test) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error":   "Candidate token does not belong to this test's candidate",
+		})
+		return false
+	}
+	return true
+}
+
+// MakeRefreshCandidateTokenHandler creates a handler that exchanges a valid
+// candidate token for a new one while the test is still active
+func MakeRefreshCandidateTokenHandler(codingTestsClient *coding_tests.Client, issuer *candidate.Issuer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+
+		token, claims, err := issuer.Issue(test.Id, test.CandidateEmail)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.CandidateTokenResponse{
+				Success: false,
+				Error:   "Failed to issue candidate token: " + err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, model.CandidateTokenResponse{
+			Success:   true,
+			Token:     token,
+			ExpiresAt: &claims.ExpiresAt,
+		})
+	}
+}
+
+// MakeReissueCandidateTokenHandler creates a handler that lets the company
+// that created a started test issue a new candidate token, for a candidate
+// who lost theirs, e.g. when their browser crashed. The company passes the
+// token on to the candidate
+func MakeReissueCandidateTokenHandler(codingTestsClient *coding_tests.Client, issuer *candidate.Issuer, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, _ := middleware.CompanyIDFromContext(c)
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		details := map[string]string{"test_id": test.Id}
+		if int(test.CompanyId) != companyID {
+			auditor.Record(c, audit.Event{
+				CompanyID: companyID,
+				Action:    audit.ActionCandidateTokenReissue,
+				Outcome:   audit.OutcomeFailure,
+				Details:   details,
+			})
+			c.JSON(http.StatusNotFound, model.CandidateTokenResponse{
+				Success: false,
+				Error:   "Test not found",
+			})
+			return
+		}
+		if test.StartedAt == nil {
+			c.JSON(http.StatusConflict, model.CandidateTokenResponse{
+				Success: false,
+				Error:   "Test has not been started",
+			})
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+
+		token, claims, err := issuer.Issue(test.Id, test.CandidateEmail)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: companyID,
+				Action:    audit.ActionCandidateTokenReissue,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.CandidateTokenResponse{
+				Success: false,
+				Error:   "Failed to issue candidate token: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionCandidateTokenReissue,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+		c.JSON(http.StatusOK, model.CandidateTokenResponse{
+			Success:   true,
+			Token:     token,
+			ExpiresAt: &claims.ExpiresAt,
+		})
+	}
+}
@@ -3,10 +3,25 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/candidate"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
 	"go-code-runner-microservice/api-gateway/internal/model"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testtimer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MakeVerifyTestHandler creates a handler for verifying a coding test
@@ -21,76 +36,28 @@ func MakeVerifyTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFu
 			return
 		}
 
-		resp, err := codingTestsClient.VerifyTest(c.Request.Context(), testID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, model.VerifyTestResponse{
-				Success: false,
-				Error:   "Failed to verify test: " + err.Error(),
-			})
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
 			return
 		}
 
-		// Convert proto CodingTest to model.CodingTest
-		test := model.CodingTest{
-			ID:                  resp.Test.Id,
-			CompanyID:           int(resp.Test.CompanyId),
-			ProblemID:           int(resp.Test.ProblemId),
-			Status:              resp.Test.Status,
-			TestDurationMinutes: int(resp.Test.TestDurationMinutes),
-		}
-
-		if resp.Test.CandidateName != "" {
-			candidateName := resp.Test.CandidateName
-			test.CandidateName = &candidateName
-		}
-
-		if resp.Test.CandidateEmail != "" {
-			candidateEmail := resp.Test.CandidateEmail
-			test.CandidateEmail = &candidateEmail
-		}
-
-		if resp.Test.SubmissionCode != "" {
-			submissionCode := resp.Test.SubmissionCode
-			test.SubmissionCode = &submissionCode
-		}
-
-		if resp.Test.PassedPercentage != 0 {
-			passedPercentage := int(resp.Test.PassedPercentage)
-			test.PassedPercentage = &passedPercentage
-		}
-
-		// Handle timestamps if they exist
-		if resp.Test.StartedAt != nil {
-			startedAt := resp.Test.StartedAt.AsTime()
-			test.StartedAt = &startedAt
-		}
-
-		if resp.Test.CompletedAt != nil {
-			completedAt := resp.Test.CompletedAt.AsTime()
-			test.CompletedAt = &completedAt
-		}
-
-		if resp.Test.ExpiresAt != nil {
-			test.ExpiresAt = resp.Test.ExpiresAt.AsTime()
-		}
-
-		if resp.Test.CreatedAt != nil {
-			test.CreatedAt = resp.Test.CreatedAt.AsTime()
-		}
-
-		if resp.Test.UpdatedAt != nil {
-			test.UpdatedAt = resp.Test.UpdatedAt.AsTime()
+		// Once started, a test is only shown to the candidate who started it
+		if test.StartedAt != nil && !checkCandidate(c, test) {
+			return
 		}
 
 		c.JSON(http.StatusOK, model.VerifyTestResponse{
 			Success: true,
-			Test:    test,
+			Test:    coding_tests.ToCodingTest(test),
 		})
 	}
 }
 
-// MakeStartTestHandler creates a handler for starting a coding test
-func MakeStartTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFunc {
+// MakeStartTestHandler creates a handler for starting a coding test. The
+// response carries the candidate token the test's other routes require; the
+// candidate holding it may call start again to resume the test. A candidate
+// who lost their token gets a new one from the company that created the test
+func MakeStartTestHandler(codingTestsClient *coding_tests.Client, issuer *candidate.Issuer, timer *testtimer.Scheduler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		testID := c.Param("test_id")
 		if testID == "" {
@@ -110,24 +77,61 @@ func MakeStartTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFun
 			return
 		}
 
-		resp, err := codingTestsClient.StartTest(c.Request.Context(), testID, req.CandidateName, req.CandidateEmail)
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+
+		if test.StartedAt != nil && !isTestCandidate(c, test) {
+			c.JSON(http.StatusConflict, model.StartTestResponse{
+				Success: false,
+				Error:   "Test has already been started in another browser; ask the company to reissue your access",
+			})
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+
+		message := "Test resumed"
+		email := test.CandidateEmail
+		if test.StartedAt == nil {
+			resp, err := codingTestsClient.StartTest(c.Request.Context(), testID, req.CandidateName, req.CandidateEmail)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, model.StartTestResponse{
+					Success: false,
+					Error:   "Failed to start test: " + err.Error(),
+				})
+				return
+			}
+			message = resp.Message
+			email = req.CandidateEmail
+			test.StartedAt = timestamppb.Now()
+		}
+		scheduleTest(timer, test)
+
+		token, claims, err := issuer.Issue(testID, email)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, model.StartTestResponse{
 				Success: false,
-				Error:   "Failed to start test: " + err.Error(),
+				Error:   "Failed to issue candidate token: " + err.Error(),
 			})
 			return
 		}
 
 		c.JSON(http.StatusOK, model.StartTestResponse{
-			Success: true,
-			Message: resp.Message,
+			Success:        true,
+			Message:        message,
+			Token:          token,
+			TokenExpiresAt: &claims.ExpiresAt,
 		})
 	}
 }
 
-// MakeSubmitTestHandler creates a handler for submitting a coding test
-func MakeSubmitTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFunc {
+// MakeSubmitTestHandler creates a handler for submitting a coding test. The
+// submitted code is graded against the pinned problem version before it is
+// stored, so the score cannot be chosen by the candidate
+func MakeSubmitTestHandler(codingTestsClient *coding_tests.Client, executorClient *executor.Client, problemsClient problems.Service, codeValidator *limits.CodeValidator, timer *testtimer.Scheduler, grace, gradeTimeout time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		testID := c.Param("test_id")
 		if testID == "" {
@@ -147,7 +151,53 @@ func MakeSubmitTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFu
 			return
 		}
 
-		resp, err := codingTestsClient.SubmitTest(c.Request.Context(), testID, req.Code, int32(req.PassedPercentage))
+		if req.Language == "" {
+			req.Language = "go"
+		}
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
+		}
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkTestOpen(c, test, grace) {
+			return
+		}
+
+		// An assessment's code is in its problem submissions, and its score
+		// is their weighted total
+		code, passed := req.Code, 0
+		if coding_tests.IsAssessment(test) {
+			code, passed = "", coding_tests.WeightedScore(test, test.Submissions)
+		} else {
+			if code == "" {
+				c.JSON(http.StatusBadRequest, model.SubmitTestResponse{
+					Success: false,
+					Error:   "Code is required",
+				})
+				return
+			}
+			if !checkLanguageAllowed(c, test, req.Language) {
+				return
+			}
+			var ok bool
+			passed, ok = gradeSubmission(c, executorClient, problemsClient, coding_tests.Problems(test)[0], req.Language, req.Code, req.Mode, gradeTimeout)
+			if !ok {
+				return
+			}
+		}
+
+		resp, err := codingTestsClient.SubmitTest(c.Request.Context(), testID, code, int32(passed))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, model.SubmitTestResponse{
 				Success: false,
@@ -156,16 +206,30 @@ func MakeSubmitTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFu
 			return
 		}
 
+		timer.Cancel(testID)
+
+		var testPassed *bool
+		if test.PassingThreshold > 0 {
+			p := int32(passed) >= test.PassingThreshold
+			testPassed = &p
+		}
+
 		c.JSON(http.StatusOK, model.SubmitTestResponse{
-			Success: true,
-			Message: resp.Message,
+			Success:    true,
+			Message:    resp.Message,
+			TotalScore: &passed,
+			Passed:     testPassed,
 		})
 	}
 }
 
-// MakeGenerateTestHandler creates a handler for generating a coding test
-func MakeGenerateTestHandler(codingTestsClient *coding_tests.Client) gin.HandlerFunc {
+// MakeGenerateTestHandler creates a handler for generating a coding test for
+// the authenticated company. Private problems can only be used by the
+// company that owns them
+func MakeGenerateTestHandler(codingTestsClient *coding_tests.Client, problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		companyID, _ := middleware.CompanyIDFromContext(c)
+
 		var req model.GenerateTestRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, model.GenerateTestResponse{
@@ -175,13 +239,60 @@ func MakeGenerateTestHandler(codingTestsClient *coding_tests.Client) gin.Handler
 			return
 		}
 
-		resp, err := codingTestsClient.GenerateTest(
-			c.Request.Context(), 
-			int32(req.CompanyID), 
-			int32(req.ProblemID), 
-			int32(req.ExpiresInHours),
-			*req.ClientID)
+		opts := coding_tests.GenerateTestOptions{
+			ProblemID:           int32(req.ProblemID),
+			ProblemVersion:      int32(req.ProblemVersion),
+			ExpiresInHours:      int32(req.ExpiresInHours),
+			TestDurationMinutes: int32(req.TestDurationMinutes),
+		}
+		switch {
+		case req.TemplateID != 0:
+			if req.ProblemID != 0 || req.ProblemVersion != 0 || len(req.Problems) > 0 || req.TestDurationMinutes != 0 {
+				c.JSON(http.StatusBadRequest, model.GenerateTestResponse{
+					Success: false,
+					Error:   "Invalid request payload: template_id cannot be combined with problem_id, problem_version, problems or test_duration_minutes",
+				})
+				return
+			}
+			tmpl, ok := loadTemplate(c, codingTestsClient, companyID, req.TemplateID)
+			if !ok {
+				return
+			}
+			opts = templateTestOptions(tmpl, req.ExpiresInHours)
+		case len(req.Problems) > 0:
+			if req.ProblemID != 0 || req.ProblemVersion != 0 {
+				c.JSON(http.StatusBadRequest, model.GenerateTestResponse{
+					Success: false,
+					Error:   "Invalid request payload: problem_id and problem_version cannot be combined with problems",
+				})
+				return
+			}
+			problems, err := assessmentProblems(req.Problems)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, model.GenerateTestResponse{
+					Success: false,
+					Error:   "Invalid request payload: " + err.Error(),
+				})
+				return
+			}
+			opts.Problems = problems
+		}
+		if !checkProblemsUsable(c, problemsClient, companyID, generatedProblems(opts)) {
+			return
+		}
+		details := map[string]string{"problem_id": generatedProblemIDs(opts)}
+		if req.TemplateID != 0 {
+			details["template_id"] = strconv.Itoa(req.TemplateID)
+		}
+
+		resp, err := codingTestsClient.GenerateTest(c.Request.Context(), int32(companyID), *req.ClientID, opts)
 		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: companyID,
+				Action:    audit.ActionCodingTestGenerate,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
 			c.JSON(http.StatusInternalServerError, model.GenerateTestResponse{
 				Success: false,
 				Error:   "Failed to generate test: " + err.Error(),
@@ -189,27 +300,16 @@ func MakeGenerateTestHandler(codingTestsClient *coding_tests.Client) gin.Handler
 			return
 		}
 
-		// Convert proto CodingTest to model.CodingTest
-		test := model.CodingTest{
-			ID:                  resp.Test.Id,
-			CompanyID:           int(resp.Test.CompanyId),
-			ProblemID:           int(resp.Test.ProblemId),
-			Status:              resp.Test.Status,
-			TestDurationMinutes: int(resp.Test.TestDurationMinutes),
-		}
+		test := coding_tests.ToCodingTest(resp.Test)
 
-		// Handle timestamps if they exist
-		if resp.Test.ExpiresAt != nil {
-			test.ExpiresAt = resp.Test.ExpiresAt.AsTime()
-		}
-
-		if resp.Test.CreatedAt != nil {
-			test.CreatedAt = resp.Test.CreatedAt.AsTime()
-		}
-
-		if resp.Test.UpdatedAt != nil {
-			test.UpdatedAt = resp.Test.UpdatedAt.AsTime()
-		}
+		details["problem_version"] = strconv.Itoa(test.ProblemVersion)
+		details["test_id"] = test.ID
+		auditor.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionCodingTestGenerate,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
 
 		c.JSON(http.StatusOK, model.GenerateTestResponse{
 			Success: true,
@@ -219,7 +319,32 @@ func MakeGenerateTestHandler(codingTestsClient *coding_tests.Client) gin.Handler
 	}
 }
 
-// MakeGetCompanyTestsHandler creates a handler for getting company tests
+// generatedProblems returns the IDs of the problems a test is generated
+// with.
+func generatedProblems(opts coding_tests.GenerateTestOptions) []int32 {
+	if len(opts.Problems) == 0 {
+		return []int32{opts.ProblemID}
+	}
+	ids := make([]int32, len(opts.Problems))
+	for i, p := range opts.Problems {
+		ids[i] = p.ProblemId
+	}
+	return ids
+}
+
+// generatedProblemIDs lists the problems a test is generated with, for the
+// audit trail.
+func generatedProblemIDs(opts coding_tests.GenerateTestOptions) string {
+	ids := generatedProblems(opts)
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = strconv.Itoa(int(id))
+	}
+	return strings.Join(out, ",")
+}
+
+// MakeGetCompanyTestsHandler creates a handler for getting the tests of the
+// authenticated company. The company ID in the path must be its own
 func MakeGetCompanyTestsHandler(codingTestsClient *coding_tests.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		companyIDStr := c.Param("company_id")
@@ -231,6 +356,13 @@ func MakeGetCompanyTestsHandler(codingTestsClient *coding_tests.Client) gin.Hand
 			})
 			return
 		}
+		if authID, _ := middleware.CompanyIDFromContext(c); companyID != authID {
+			c.JSON(http.StatusForbidden, model.GetCompanyTestsResponse{
+				Success: false,
+				Error:   "Tests of other companies cannot be listed",
+			})
+			return
+		}
 
 		resp, err := codingTestsClient.GetCompanyTests(c.Request.Context(), int32(companyID))
 		if err != nil {
@@ -243,63 +375,195 @@ func MakeGetCompanyTestsHandler(codingTestsClient *coding_tests.Client) gin.Hand
 
 		tests := make([]model.CodingTest, len(resp.Tests))
 		for i, t := range resp.Tests {
-			test := model.CodingTest{
-				ID:                  t.Id,
-				CompanyID:           int(t.CompanyId),
-				ProblemID:           int(t.ProblemId),
-				Status:              t.Status,
-				TestDurationMinutes: int(t.TestDurationMinutes),
-			}
+			tests[i] = coding_tests.ToCodingTest(t)
+		}
 
-			if t.CandidateName != "" {
-				candidateName := t.CandidateName
-				test.CandidateName = &candidateName
-			}
+		c.JSON(http.StatusOK, model.GetCompanyTestsResponse{
+			Success: true,
+			Tests:   tests,
+		})
+	}
+}
 
-			if t.CandidateEmail != "" {
-				candidateEmail := t.CandidateEmail
-				test.CandidateEmail = &candidateEmail
-			}
+// loadTest fetches a coding test for the candidate-facing routes. On failure
+// it writes the response and returns nil.
+func loadTest(c *gin.Context, codingTestsClient *coding_tests.Client) *codingtestspb.CodingTest {
+	resp, err := codingTestsClient.VerifyTest(c.Request.Context(), c.Param("test_id"))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Test not found",
+			})
+			return nil
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to verify test: " + err.Error(),
+		})
+		return nil
+	}
+	if resp.Test == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Test not found",
+		})
+		return nil
+	}
+	return resp.Test
+}
 
-			if t.SubmissionCode != "" {
-				submissionCode := t.SubmissionCode
-				test.SubmissionCode = &submissionCode
-			}
+// MakeGetTestProblemHandler creates a handler that returns the problem of a
+// coding test at the version pinned when the test was generated. Hidden test
+// cases are left out. For multi-problem assessments the problem_id query
+// parameter picks the problem, defaulting to the first. Only the candidate
+// who started the test can read it, and only while the test is open.
+func MakeGetTestProblemHandler(codingTestsClient *coding_tests.Client, problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, ok := problemIDQuery(c)
+		if !ok {
+			return
+		}
 
-			if t.PassedPercentage != 0 {
-				passedPercentage := int(t.PassedPercentage)
-				test.PassedPercentage = &passedPercentage
-			}
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+		problem := testProblem(c, test, problemID)
+		if problem == nil {
+			return
+		}
 
-			// Handle timestamps if they exist
-			if t.StartedAt != nil {
-				startedAt := t.StartedAt.AsTime()
-				test.StartedAt = &startedAt
-			}
+		resp, err := problemsClient.GetProblemVersion(c.Request.Context(), problem.ProblemId, problem.ProblemVersion)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.TestProblemResponse{
+				Success: false,
+				Error:   "Failed to get problem: " + err.Error(),
+			})
+			return
+		}
+		// Tests generated before ownership was checked may still point at
+		// another company's private problem
+		if !problemVisibleTo(resp.Version.Problem, test.CompanyId) {
+			c.JSON(http.StatusNotFound, model.TestProblemResponse{
+				Success: false,
+				Error:   "Problem not found",
+			})
+			return
+		}
 
-			if t.CompletedAt != nil {
-				completedAt := t.CompletedAt.AsTime()
-				test.CompletedAt = &completedAt
+		var visible []*problemspb.TestCase
+		for _, tc := range resp.Version.TestCases {
+			if !tc.IsHidden {
+				visible = append(visible, tc)
 			}
+		}
 
-			if t.ExpiresAt != nil {
-				test.ExpiresAt = t.ExpiresAt.AsTime()
-			}
+		p := toProblemResponse(resp.Version.Problem)
+		c.Header("Cache-Control", "private, no-cache")
+		c.JSON(http.StatusOK, model.TestProblemResponse{
+			Success:   true,
+			Problem:   &p,
+			TestCases: toTestCaseResponses(visible),
+		})
+	}
+}
 
-			if t.CreatedAt != nil {
-				test.CreatedAt = t.CreatedAt.AsTime()
-			}
+// MakeExecuteTestHandler creates a handler that runs a candidate's code
+// against the test cases of the problem version pinned by their test, or of
+// the assessment problem given in the request
+func MakeExecuteTestHandler(codingTestsClient *coding_tests.Client, executorClient *executor.Client, codeValidator *limits.CodeValidator, registry *jobs.Registry, timer *testtimer.Scheduler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ExecuteTestRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
 
-			if t.UpdatedAt != nil {
-				test.UpdatedAt = t.UpdatedAt.AsTime()
-			}
+		if req.Language != "go" {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "Unsupported language. Only 'go' is supported.",
+			})
+			return
+		}
 
-			tests[i] = test
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
 		}
 
-		c.JSON(http.StatusOK, model.GetCompanyTestsResponse{
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkLanguageAllowed(c, test, req.Language) {
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+		problem := testProblem(c, test, req.ProblemID)
+		if problem == nil {
+			return
+		}
+		scheduleTest(timer, test)
+		timer.Remember(test.Id, testtimer.Snapshot{
+			ProblemID: draftProblemID(test, problem),
+			Language:  req.Language,
+			Code:      req.Code,
+			Harness:   req.Mode == model.ExecutionModeHarness,
+		})
+
+		// The candidate, identified by their token, owns the job; the
+		// company that created the test may read it too.
+		owner := jobOwner(c)
+		owner.CompanyID = int(test.CompanyId)
+
+		resp, err := executorClient.Execute(c.Request.Context(), req.Language, req.Code, executor.ExecuteOptions{
+			ProblemID:      int(problem.ProblemId),
+			ProblemVersion: int(problem.ProblemVersion),
+			Harness:        req.Mode == model.ExecutionModeHarness,
+			Owner:          owner,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.ExecuteResponse{
+				Success: false,
+				Error:   "Failed to execute: " + err.Error(),
+			})
+			return
+		}
+
+		if !resp.Success {
+			c.JSON(http.StatusInternalServerError, model.ExecuteResponse{
+				Success: false,
+				Error:   resp.Error,
+			})
+			return
+		}
+
+		trackJob(c, executorClient, registry, resp.JobId, owner)
+
+		c.JSON(http.StatusAccepted, model.ExecuteResponse{
 			Success: true,
-			Tests:   tests,
+			JobID:   resp.JobId,
+			Message: resp.Message,
 		})
 	}
 }
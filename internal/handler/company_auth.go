@@ -5,18 +5,22 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/audit"
 	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
 	"go-code-runner-microservice/api-gateway/internal/model"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
 )
 
 type CompanyHandler struct {
 	client *company_auth.Client
+	audit  *audit.Recorder
 }
 
-func NewCompanyHandler(client *company_auth.Client) *CompanyHandler {
+func NewCompanyHandler(client *company_auth.Client, auditor *audit.Recorder) *CompanyHandler {
 	return &CompanyHandler{
 		client: client,
+		audit:  auditor,
 	}
 }
 
@@ -50,6 +54,11 @@ func (h *CompanyHandler) Register(c *gin.Context) {
 			zap.String("company_name", req.Name),
 			zap.String("email", req.Email),
 		)
+		h.audit.Record(c, audit.Event{
+			Actor:   req.Email,
+			Action:  audit.ActionCompanyRegister,
+			Outcome: audit.OutcomeError,
+		})
 		c.JSON(http.StatusInternalServerError, model.RegisterResponse{
 			Success: false,
 			Error:   "Failed to register company: " + err.Error(),
@@ -66,6 +75,12 @@ func (h *CompanyHandler) Register(c *gin.Context) {
 			zap.String("reason", errorMsg),
 			zap.String("email", req.Email),
 		)
+		h.audit.Record(c, audit.Event{
+			Actor:   req.Email,
+			Action:  audit.ActionCompanyRegister,
+			Outcome: audit.OutcomeFailure,
+			Details: map[string]string{"reason": errorMsg},
+		})
 		c.JSON(http.StatusBadRequest, model.RegisterResponse{
 			Success: false,
 			Error:   errorMsg,
@@ -94,6 +109,16 @@ func (h *CompanyHandler) Register(c *gin.Context) {
 		)
 	}
 
+	registered := audit.Event{
+		Actor:   req.Email,
+		Action:  audit.ActionCompanyRegister,
+		Outcome: audit.OutcomeSuccess,
+	}
+	if company != nil {
+		registered.CompanyID = company.ID
+	}
+	h.audit.Record(c, registered)
+
 	c.JSON(http.StatusOK, model.RegisterResponse{
 		Success: true,
 		Company: company,
@@ -127,6 +152,11 @@ func (h *CompanyHandler) Login(c *gin.Context) {
 			zap.Error(err),
 			zap.String("email", req.Email),
 		)
+		h.audit.Record(c, audit.Event{
+			Actor:   req.Email,
+			Action:  audit.ActionCompanyLogin,
+			Outcome: audit.OutcomeError,
+		})
 		c.JSON(http.StatusInternalServerError, model.LoginResponse{
 			Success: false,
 			Error:   "Failed to login: " + err.Error(),
@@ -144,6 +174,12 @@ func (h *CompanyHandler) Login(c *gin.Context) {
 			zap.String("email", req.Email),
 			zap.String("client_ip", c.ClientIP()),
 		)
+		h.audit.Record(c, audit.Event{
+			Actor:   req.Email,
+			Action:  audit.ActionCompanyLogin,
+			Outcome: audit.OutcomeFailure,
+			Details: map[string]string{"reason": errorMsg},
+		})
 		c.JSON(http.StatusUnauthorized, model.LoginResponse{
 			Success: false,
 			Error:   errorMsg,
@@ -171,6 +207,16 @@ func (h *CompanyHandler) Login(c *gin.Context) {
 		)
 	}
 
+	loggedIn := audit.Event{
+		Actor:   req.Email,
+		Action:  audit.ActionCompanyLogin,
+		Outcome: audit.OutcomeSuccess,
+	}
+	if company != nil {
+		loggedIn.CompanyID = company.ID
+	}
+	h.audit.Record(c, loggedIn)
+
 	token := ""
 	if resp.Token != nil {
 		token = *resp.Token
@@ -186,27 +232,23 @@ func (h *CompanyHandler) Login(c *gin.Context) {
 func (h *CompanyHandler) GenerateAPIKey(c *gin.Context) {
 	log := logger.WithContext(c.Request.Context())
 
-	var req model.GenerateAPIKeyRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid generate API key request", zap.Error(err))
-
-		c.JSON(http.StatusBadRequest, model.GenerateAPIKeyResponse{
-			Success: false,
-			Error:   "Invalid request: " + err.Error(),
-		})
-		return
-	}
+	companyID, _ := middleware.CompanyIDFromContext(c)
 
 	log.Info("generating API key",
-		zap.Int("company_id", req.CompanyID),
+		zap.Int("company_id", companyID),
 	)
 
-	resp, err := h.client.GenerateAPIKey(c.Request.Context(), int32(req.CompanyID))
+	resp, err := h.client.GenerateAPIKey(c.Request.Context(), int32(companyID))
 	if err != nil {
 		log.Error("failed to generate API key",
 			zap.Error(err),
-			zap.Int("company_id", req.CompanyID),
+			zap.Int("company_id", companyID),
 		)
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionAPIKeyGenerate,
+			Outcome:   audit.OutcomeError,
+		})
 		c.JSON(http.StatusInternalServerError, model.GenerateAPIKeyResponse{
 			Success: false,
 			Error:   "Failed to generate API key: " + err.Error(),
@@ -221,8 +263,14 @@ func (h *CompanyHandler) GenerateAPIKey(c *gin.Context) {
 		}
 		log.Warn("API key generation failed",
 			zap.String("reason", errorMsg),
-			zap.Int("company_id", req.CompanyID),
+			zap.Int("company_id", companyID),
 		)
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionAPIKeyGenerate,
+			Outcome:   audit.OutcomeFailure,
+			Details:   map[string]string{"reason": errorMsg},
+		})
 		c.JSON(http.StatusBadRequest, model.GenerateAPIKeyResponse{
 			Success: false,
 			Error:   errorMsg,
@@ -236,8 +284,13 @@ func (h *CompanyHandler) GenerateAPIKey(c *gin.Context) {
 	}
 
 	log.Info("API key generated successfully",
-		zap.Int("company_id", req.CompanyID),
+		zap.Int("company_id", companyID),
 	)
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionAPIKeyGenerate,
+		Outcome:   audit.OutcomeSuccess,
+	})
 
 	c.JSON(http.StatusOK, model.GenerateAPIKeyResponse{
 		Success: true,
@@ -248,27 +301,23 @@ func (h *CompanyHandler) GenerateAPIKey(c *gin.Context) {
 func (h *CompanyHandler) GenerateClientID(c *gin.Context) {
 	log := logger.WithContext(c.Request.Context())
 
-	var req model.GenerateClientIDRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn("invalid generate client ID request", zap.Error(err))
-
-		c.JSON(http.StatusBadRequest, model.GenerateClientIDResponse{
-			Success: false,
-			Error:   "Invalid request: " + err.Error(),
-		})
-		return
-	}
+	companyID, _ := middleware.CompanyIDFromContext(c)
 
 	log.Info("generating client ID",
-		zap.Int("company_id", req.CompanyID),
+		zap.Int("company_id", companyID),
 	)
 
-	resp, err := h.client.GenerateClientID(c.Request.Context(), int32(req.CompanyID))
+	resp, err := h.client.GenerateClientID(c.Request.Context(), int32(companyID))
 	if err != nil {
 		log.Error("failed to generate client ID",
 			zap.Error(err),
-			zap.Int("company_id", req.CompanyID),
+			zap.Int("company_id", companyID),
 		)
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionClientIDGenerate,
+			Outcome:   audit.OutcomeError,
+		})
 		c.JSON(http.StatusInternalServerError, model.GenerateClientIDResponse{
 			Success: false,
 			Error:   "Failed to generate client ID: " + err.Error(),
@@ -283,8 +332,14 @@ func (h *CompanyHandler) GenerateClientID(c *gin.Context) {
 		}
 		log.Warn("client ID generation failed",
 			zap.String("reason", errorMsg),
-			zap.Int("company_id", req.CompanyID),
+			zap.Int("company_id", companyID),
 		)
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionClientIDGenerate,
+			Outcome:   audit.OutcomeFailure,
+			Details:   map[string]string{"reason": errorMsg},
+		})
 		c.JSON(http.StatusBadRequest, model.GenerateClientIDResponse{
 			Success: false,
 			Error:   errorMsg,
@@ -297,8 +352,13 @@ func (h *CompanyHandler) GenerateClientID(c *gin.Context) {
 		clientID = *resp.ClientId
 	}
 	log.Info("client ID generated successfully",
-		zap.Int("company_id", req.CompanyID),
+		zap.Int("company_id", companyID),
 	)
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionClientIDGenerate,
+		Outcome:   audit.OutcomeSuccess,
+	})
 
 	c.JSON(http.StatusOK, model.GenerateClientIDResponse{
 		Success:  true,
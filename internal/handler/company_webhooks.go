@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	companyauthpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/company_auth/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
+	"go-code-runner-microservice/api-gateway/internal/webhook"
+)
+
+// CompanyWebhookHandler manages a company's subscriptions to coding test
+// events and the deliveries made for them.
+type CompanyWebhookHandler struct {
+	client     *company_auth.Client
+	dispatcher *webhook.Dispatcher
+	// jobSecret is the key company job callback secrets are derived from;
+	// job callbacks may be redelivered as well.
+	jobSecret []byte
+	audit     *audit.Recorder
+}
+
+func NewCompanyWebhookHandler(client *company_auth.Client, dispatcher *webhook.Dispatcher, jobSecret string, auditor *audit.Recorder) *CompanyWebhookHandler {
+	return &CompanyWebhookHandler{
+		client:     client,
+		dispatcher: dispatcher,
+		jobSecret:  []byte(jobSecret),
+		audit:      auditor,
+	}
+}
+
+// Create subscribes a URL to coding test events. The response includes the
+// signing secret, which is not shown again.
+func (h *CompanyWebhookHandler) Create(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	var req model.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, model.WebhookSubscriptionResponse{
+			Success: false,
+			Error:   "Invalid request payload: " + err.Error(),
+		})
+		return
+	}
+	if !isWebhookURL(req.URL) {
+		c.JSON(http.StatusBadRequest, model.WebhookSubscriptionResponse{
+			Success: false,
+			Error:   "url must be an absolute http or https URL",
+		})
+		return
+	}
+
+	resp, err := h.client.CreateWebhookSubscription(c.Request.Context(), int32(companyID), req.URL, req.Events)
+	if err != nil {
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionWebhookCreate,
+			Outcome:   audit.OutcomeError,
+		})
+		c.JSON(http.StatusInternalServerError, model.WebhookSubscriptionResponse{
+			Success: false,
+			Error:   "Failed to create webhook: " + err.Error(),
+		})
+		return
+	}
+	if !resp.Success || resp.Subscription == nil {
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionWebhookCreate,
+			Outcome:   audit.OutcomeFailure,
+		})
+		c.JSON(http.StatusBadRequest, model.WebhookSubscriptionResponse{
+			Success: false,
+			Error:   resp.GetError(),
+		})
+		return
+	}
+
+	sub := toWebhookSubscription(resp.Subscription)
+	sub.Secret = resp.Subscription.Secret
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionWebhookCreate,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   map[string]string{"webhook_id": strconv.Itoa(sub.ID), "url": sub.URL},
+	})
+
+	c.JSON(http.StatusCreated, model.WebhookSubscriptionResponse{
+		Success: true,
+		Webhook: &sub,
+	})
+}
+
+// List returns the company's subscriptions without their secrets.
+func (h *CompanyWebhookHandler) List(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	resp, err := h.client.ListWebhookSubscriptions(c.Request.Context(), int32(companyID))
+	if err == nil && !resp.Success {
+		err = errors.New(resp.GetError())
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.ListWebhookSubscriptionsResponse{
+			Success: false,
+			Error:   "Failed to list webhooks: " + err.Error(),
+		})
+		return
+	}
+
+	webhooks := make([]model.WebhookSubscription, len(resp.Subscriptions))
+	for i, sub := range resp.Subscriptions {
+		webhooks[i] = toWebhookSubscription(sub)
+	}
+	c.JSON(http.StatusOK, model.ListWebhookSubscriptionsResponse{
+		Success:  true,
+		Webhooks: webhooks,
+	})
+}
+
+// Delete removes a subscription. Deliveries already queued for it are still
+// attempted.
+func (h *CompanyWebhookHandler) Delete(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	id, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, model.DeleteWebhookResponse{
+			Success: false,
+			Error:   "Invalid webhook ID: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.client.DeleteWebhookSubscription(c.Request.Context(), int32(id), int32(companyID))
+	if err != nil {
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionWebhookDelete,
+			Outcome:   audit.OutcomeError,
+			Details:   map[string]string{"webhook_id": strconv.Itoa(id)},
+		})
+		c.JSON(http.StatusInternalServerError, model.DeleteWebhookResponse{
+			Success: false,
+			Error:   "Failed to delete webhook: " + err.Error(),
+		})
+		return
+	}
+	if !resp.Success {
+		h.audit.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionWebhookDelete,
+			Outcome:   audit.OutcomeFailure,
+			Details:   map[string]string{"webhook_id": strconv.Itoa(id)},
+		})
+		c.JSON(http.StatusNotFound, model.DeleteWebhookResponse{
+			Success: false,
+			Error:   "Webhook not found",
+		})
+		return
+	}
+
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionWebhookDelete,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   map[string]string{"webhook_id": strconv.Itoa(id)},
+	})
+	c.JSON(http.StatusOK, model.DeleteWebhookResponse{
+		Success: true,
+		Message: "Webhook deleted",
+	})
+}
+
+// Ping sends a ping event to a subscription so that the receiver's
+// signature check can be tested. Its outcome is in the delivery log.
+func (h *CompanyWebhookHandler) Ping(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	id, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Invalid webhook ID: " + err.Error(),
+		})
+		return
+	}
+
+	sub, err := h.subscription(c.Request.Context(), companyID, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Failed to get webhook: " + err.Error(),
+		})
+		return
+	}
+	if sub == nil {
+		c.JSON(http.StatusNotFound, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Webhook not found",
+		})
+		return
+	}
+
+	delivery, err := h.dispatcher.Send(c.Request.Context(), webhook.Message{
+		CompanyID: companyID,
+		WebhookID: id,
+		URL:       sub.Url,
+		Event:     webhook.EventPing,
+		Data:      gin.H{"webhook_id": id},
+	}, []byte(sub.Secret))
+	respondQueued(c, delivery, err)
+}
+
+// ListDeadLetters returns the company's deliveries that failed every
+// attempt, optionally for one webhook_id.
+func (h *CompanyWebhookHandler) ListDeadLetters(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	filter := webhook.Filter{CompanyID: companyID, Status: webhook.StatusFailed}
+	if v := c.Query("webhook_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.ListWebhookDeliveriesResponse{
+				Success: false,
+				Error:   "Invalid webhook ID: " + err.Error(),
+			})
+			return
+		}
+		filter.WebhookID = id
+	}
+
+	deliveries, err := h.dispatcher.Log().List(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.ListWebhookDeliveriesResponse{
+			Success: false,
+			Error:   "Failed to list deliveries: " + err.Error(),
+		})
+		return
+	}
+	resp := model.ListWebhookDeliveriesResponse{
+		Success:    true,
+		Deliveries: make([]model.WebhookDelivery, len(deliveries)),
+	}
+	for i, d := range deliveries {
+		resp.Deliveries[i] = toWebhookDelivery(d)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Redeliver sends a delivery again with the same payload, signed with the
+// current secret of its subscription.
+func (h *CompanyWebhookHandler) Redeliver(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	d, ok, err := h.dispatcher.Log().Get(c.Request.Context(), companyID, c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Failed to get delivery: " + err.Error(),
+		})
+		return
+	}
+	if !ok {
+		c.JSON(http.StatusNotFound, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Delivery not found",
+		})
+		return
+	}
+
+	secret := h.jobSecret
+	if d.WebhookID != 0 {
+		sub, err := h.subscription(c.Request.Context(), companyID, d.WebhookID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.WebhookDeliveryResponse{
+				Success: false,
+				Error:   "Failed to get webhook: " + err.Error(),
+			})
+			return
+		}
+		if sub == nil {
+			c.JSON(http.StatusConflict, model.WebhookDeliveryResponse{
+				Success: false,
+				Error:   "The webhook of this delivery has been deleted",
+			})
+			return
+		}
+		secret = []byte(sub.Secret)
+	} else if len(secret) == 0 {
+		c.JSON(http.StatusConflict, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Webhooks are not configured",
+		})
+		return
+	} else {
+		secret = webhook.CompanySecret(secret, companyID)
+	}
+
+	delivery, err := h.dispatcher.Redeliver(c.Request.Context(), d, secret)
+	outcome := audit.OutcomeSuccess
+	if err != nil {
+		outcome = audit.OutcomeError
+	}
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionWebhookRedeliver,
+		Outcome:   outcome,
+		Details:   map[string]string{"delivery_id": d.ID, "redelivery_id": delivery.ID},
+	})
+	respondQueued(c, delivery, err)
+}
+
+// CallbackSecret returns the secret the company's job callbacks are signed
+// with. It is the same on every call.
+func (h *CompanyWebhookHandler) CallbackSecret(c *gin.Context) {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	if len(h.jobSecret) == 0 {
+		c.JSON(http.StatusConflict, model.CallbackSecretResponse{
+			Success: false,
+			Error:   "Webhooks are not configured",
+		})
+		return
+	}
+
+	h.audit.Record(c, audit.Event{
+		CompanyID: companyID,
+		Action:    audit.ActionCallbackSecretRead,
+		Outcome:   audit.OutcomeSuccess,
+	})
+	c.JSON(http.StatusOK, model.CallbackSecretResponse{
+		Success: true,
+		Secret:  string(webhook.CompanySecret(h.jobSecret, companyID)),
+	})
+}
+
+// respondQueued responds 202 with a delivery that was just queued.
+func respondQueued(c *gin.Context, d webhook.Delivery, err error) {
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, model.WebhookDeliveryResponse{
+			Success: false,
+			Error:   "Failed to queue delivery: " + err.Error(),
+		})
+		return
+	}
+
+	delivery := toWebhookDelivery(d)
+	c.JSON(http.StatusAccepted, model.WebhookDeliveryResponse{
+		Success:  true,
+		Delivery: &delivery,
+	})
+}
+
+// subscription returns the company's subscription with the given ID, or nil
+// if there is none.
+func (h *CompanyWebhookHandler) subscription(ctx context.Context, companyID, id int) (*companyauthpb.WebhookSubscription, error) {
+	resp, err := h.client.ListWebhookSubscriptions(ctx, int32(companyID))
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.GetError())
+	}
+	for _, sub := range resp.Subscriptions {
+		if int(sub.Id) == id {
+			return sub, nil
+		}
+	}
+	return nil, nil
+}
+
+func toWebhookSubscription(sub *companyauthpb.WebhookSubscription) model.WebhookSubscription {
+	out := model.WebhookSubscription{
+		ID:     int(sub.Id),
+		URL:    sub.Url,
+		Events: sub.Events,
+		Active: sub.Active,
+	}
+	if sub.CreatedAt != nil {
+		out.CreatedAt = sub.CreatedAt.AsTime()
+	}
+	return out
+}
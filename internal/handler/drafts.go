@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/drafts"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/testtimer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MakeSaveDraftHandler creates a handler that saves a new version of the
+// candidate's code while their test is open. Drafts of each problem of a
+// multi-problem assessment are versioned and throttled separately. Saves of a
+// problem closer together than the throttle's interval are rejected with 429
+// and a Retry-After header; the code is still kept for auto-submission
+func MakeSaveDraftHandler(codingTestsClient *coding_tests.Client, codeValidator *limits.CodeValidator, throttle *drafts.Throttle, timer *testtimer.Scheduler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.SaveDraftRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.DraftResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
+		}
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkLanguageAllowed(c, test, req.Language) {
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+		problem := testProblem(c, test, req.ProblemID)
+		if problem == nil {
+			return
+		}
+		problemID := draftProblemID(test, problem)
+
+		mode := req.Mode
+		if mode == "" {
+			mode = model.ExecutionModeProgram
+		}
+		scheduleTest(timer, test)
+		timer.Remember(test.Id, testtimer.Snapshot{
+			ProblemID: problemID,
+			Language:  req.Language,
+			Code:      req.Code,
+			Harness:   mode == model.ExecutionModeHarness,
+		})
+
+		if wait, ok := throttle.Allow(test.Id, problemID); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, model.DraftResponse{
+				Success: false,
+				Error:   "Drafts are being saved too often",
+			})
+			return
+		}
+
+		resp, err := codingTestsClient.SaveDraft(c.Request.Context(), test.Id, problemID, req.Language, mode, req.Code)
+		if err != nil {
+			throttle.Forget(test.Id, problemID)
+			c.JSON(http.StatusInternalServerError, model.DraftResponse{
+				Success: false,
+				Error:   "Failed to save draft: " + err.Error(),
+			})
+			return
+		}
+
+		draft := coding_tests.ToDraft(resp.Draft)
+		c.JSON(http.StatusOK, model.DraftResponse{
+			Success: true,
+			Draft:   &draft,
+		})
+	}
+}
+
+// MakeGetDraftHandler creates a handler that returns the candidate's latest
+// draft, or the one given by the version query parameter, along with the
+// versions saved so far. For multi-problem assessments the problem_id query
+// parameter picks the problem, defaulting to the first
+func MakeGetDraftHandler(codingTestsClient *coding_tests.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		version := 0
+		if v := c.Query("version"); v != "" {
+			var err error
+			version, err = strconv.Atoi(v)
+			if err != nil || version < 1 {
+				c.JSON(http.StatusBadRequest, model.DraftResponse{
+					Success: false,
+					Error:   "Invalid draft version: " + v,
+				})
+				return
+			}
+		}
+
+		problemID, ok := problemIDQuery(c)
+		if !ok {
+			return
+		}
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		problem := testProblem(c, test, problemID)
+		if problem == nil {
+			return
+		}
+
+		resp, err := codingTestsClient.GetDraft(c.Request.Context(), test.Id, draftProblemID(test, problem), int32(version))
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				c.JSON(http.StatusNotFound, model.DraftResponse{
+					Success: false,
+					Error:   "Draft not found",
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, model.DraftResponse{
+				Success: false,
+				Error:   "Failed to get draft: " + err.Error(),
+			})
+			return
+		}
+
+		draft := coding_tests.ToDraft(resp.Draft)
+		versions := make([]model.DraftVersion, len(resp.Versions))
+		for i, v := range resp.Versions {
+			versions[i] = model.DraftVersion{Version: int(v.Version)}
+			if v.SavedAt != nil {
+				versions[i].SavedAt = v.SavedAt.AsTime()
+			}
+		}
+
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, model.DraftResponse{
+			Success:  true,
+			Draft:    &draft,
+			Versions: versions,
+		})
+	}
+}
@@ -1,15 +1,30 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/limits"
 	"go-code-runner-microservice/api-gateway/internal/model"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
 )
 
-func MakeExecuteHandler(executorClient *executor.Client) gin.HandlerFunc {
+// MakeExecuteHandler creates a handler that runs code against a problem's
+// test cases. With ?wait=SECONDS it responds once the job finishes, up to
+// maxWait; with a callback_url the result is also delivered as a webhook.
+// Private problems are only open to their owning company.
+func MakeExecuteHandler(executorClient *executor.Client, problemsClient problems.Service, codeValidator *limits.CodeValidator, registry *jobs.Registry, callbacks *JobCallbacks, maxWait time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		wait, ok := parseWait(c, maxWait)
+		if !ok {
+			return
+		}
+
 		var req model.ExecuteRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
@@ -27,7 +42,40 @@ func MakeExecuteHandler(executorClient *executor.Client) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := executorClient.Execute(c.Request.Context(), req.Language, req.Code, req.ProblemID)
+		if !callbacks.checkCallbackURL(c, req.CallbackURL) {
+			return
+		}
+
+		harness := req.Mode == model.ExecutionModeHarness
+		if harness && req.ProblemID == 0 {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "problem_id is required in harness mode",
+			})
+			return
+		}
+
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
+		}
+
+		// Private problems' test data comes back in job results, so only
+		// callers who can see the problem may run code against it
+		if req.ProblemID != 0 && !checkProblemViewable(c, problemsClient, int32(req.ProblemID)) {
+			return
+		}
+
+		owner := jobOwner(c)
+		resp, err := executorClient.Execute(c.Request.Context(), req.Language, req.Code, executor.ExecuteOptions{
+			ProblemID: req.ProblemID,
+			Harness:   harness,
+			Owner:     owner,
+		})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, model.ExecuteResponse{
 				Success: false,
@@ -44,19 +92,107 @@ func MakeExecuteHandler(executorClient *executor.Client) gin.HandlerFunc {
 			return
 		}
 
-		c.JSON(http.StatusAccepted, model.ExecuteResponse{
-			Success: true,
-			JobID:   resp.JobId,
-			Message: resp.Message,
+		trackJob(c, executorClient, registry, resp.JobId, owner)
+		callbacks.watch(c, req.CallbackURL, resp.JobId)
+		respondSubmitted(c, executorClient, registry, resp, wait)
+	}
+}
+
+// MakeRunHandler creates a handler that runs code once on caller-provided
+// stdin, without grading it against a problem's test cases. It accepts
+// ?wait=SECONDS like MakeExecuteHandler.
+func MakeRunHandler(executorClient *executor.Client, problemsClient problems.Service, codeValidator *limits.CodeValidator, registry *jobs.Registry, maxWait time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		wait, ok := parseWait(c, maxWait)
+		if !ok {
+			return
+		}
+
+		var req model.RunRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		if req.Language != "go" {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "Unsupported language. Only 'go' is supported.",
+			})
+			return
+		}
+
+		harness := req.Mode == model.ExecutionModeHarness
+		if harness && req.ProblemID == 0 {
+			c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+				Success: false,
+				Error:   "problem_id is required in harness mode",
+			})
+			return
+		}
+
+		if violation := codeValidator.Validate(req.Language, req.Code); violation != nil {
+			c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success:   false,
+				Error:     violation.Message,
+				Violation: violation,
+			})
+			return
+		}
+
+		// Private problems' test data comes back in job results, so only
+		// callers who can see the problem may run code against it
+		if req.ProblemID != 0 && !checkProblemViewable(c, problemsClient, int32(req.ProblemID)) {
+			return
+		}
+
+		input := &executorpb.RunInput{
+			Stdin:          req.Stdin,
+			ExpectedOutput: req.ExpectedOutput,
+		}
+		owner := jobOwner(c)
+		resp, err := executorClient.Run(c.Request.Context(), req.Language, req.Code, input, executor.ExecuteOptions{
+			ProblemID: req.ProblemID,
+			Harness:   harness,
+			Owner:     owner,
 		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.ExecuteResponse{
+				Success: false,
+				Error:   "Failed to run: " + err.Error(),
+			})
+			return
+		}
+
+		if !resp.Success {
+			c.JSON(http.StatusInternalServerError, model.ExecuteResponse{
+				Success: false,
+				Error:   resp.Error,
+			})
+			return
+		}
+
+		trackJob(c, executorClient, registry, resp.JobId, owner)
+		respondSubmitted(c, executorClient, registry, resp, wait)
 	}
 }
 
-func MakeJobStatusHandler(executorClient *executor.Client) gin.HandlerFunc {
+// MakeJobStatusHandler creates a handler for reading a job's status and
+// results. Only the job's submitter or owning company may read it; anyone
+// else gets 404. With ?wait=SECONDS it holds the request until the job
+// finishes, up to maxWait, and answers 202 if it is still running.
+func MakeJobStatusHandler(executorClient *executor.Client, registry *jobs.Registry, maxWait time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		jobID := c.Param("job_id")
+		wait, ok := parseWait(c, maxWait)
+		if !ok {
+			return
+		}
 
-		resp, err := executorClient.GetJobStatus(c.Request.Context(), jobID)
+		resp, found, err := ownedJob(c, executorClient, jobID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"success": false,
@@ -64,37 +200,41 @@ func MakeJobStatusHandler(executorClient *executor.Client) gin.HandlerFunc {
 			})
 			return
 		}
-
-		if !resp.Success {
+		if !found {
 			c.JSON(http.StatusNotFound, gin.H{
 				"success": false,
-				"error":   resp.Error,
+				"error":   "Job not found",
 			})
 			return
 		}
 
-		response := model.JobStatusResponse{
-			Success: true,
-			JobID:   resp.JobId,
-			Status:  resp.Status,
-			Output:  resp.Output,
-			Error:   resp.Error,
+		if wait > 0 && !executor.IsTerminal(resp.Status) {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), wait)
+			resp, err = executorClient.AwaitJob(ctx, jobID)
+			cancel()
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"success": false,
+					"error":   "Failed to get job status: " + err.Error(),
+				})
+				return
+			}
+			if !resp.Success {
+				c.JSON(http.StatusNotFound, gin.H{
+					"success": false,
+					"error":   resp.Error,
+				})
+				return
+			}
 		}
 
-		if len(resp.TestResults) > 0 {
-			response.TestResults = make([]model.TestResult, len(resp.TestResults))
-			for i, tr := range resp.TestResults {
-				response.TestResults[i] = model.TestResult{
-					TestCaseID:     int(tr.TestCaseId),
-					Input:          tr.Input,
-					ExpectedOutput: tr.ExpectedOutput,
-					ActualOutput:   tr.ActualOutput,
-					Error:          tr.Error,
-					Passed:         tr.Passed,
-				}
-			}
+		code := http.StatusOK
+		if executor.IsTerminal(resp.Status) {
+			registry.Finish(jobID)
+		} else if wait > 0 {
+			code = http.StatusAccepted
 		}
 
-		c.JSON(http.StatusOK, response)
+		c.JSON(code, executor.ToJobStatusResponse(resp))
 	}
 }
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	sessionOwnerPrefix   = "session:"
+	companyOwnerPrefix   = "company:"
+	candidateOwnerPrefix = "candidate:"
+
+	supersededCancelTimeout = 5 * time.Second
+)
+
+// callerKey identifies the caller: the authenticated company, else the
+// candidate taking a test, else the anonymous session.
+func callerKey(c *gin.Context) string {
+	if companyID, ok := middleware.CompanyIDFromContext(c); ok {
+		return companyOwnerPrefix + strconv.Itoa(companyID)
+	}
+	if claims, ok := middleware.CandidateFromContext(c); ok {
+		return candidateOwnerPrefix + claims.TestID + ":" + claims.Email
+	}
+	if sessionID, ok := middleware.SessionIDFromContext(c); ok {
+		return sessionOwnerPrefix + sessionID
+	}
+	return ""
+}
+
+// jobOwner is the owner of a job the caller submits on their own behalf.
+func jobOwner(c *gin.Context) jobs.Owner {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+	return jobs.Owner{Key: callerKey(c), CompanyID: companyID}
+}
+
+// ownedJob fetches the status of a job the caller submitted or whose company
+// they belong to. The owner is stored with the job by the executor, so any
+// replica can check it. found is false for unknown jobs and jobs of other
+// owners alike.
+func ownedJob(c *gin.Context, executorClient *executor.Client, jobID string) (job *executorpb.GetJobStatusResponse, found bool, err error) {
+	job, err = executorClient.GetJobStatus(c.Request.Context(), jobID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if !job.Success {
+		return nil, false, nil
+	}
+	owner, ok := executor.ToJobOwner(job.Owner)
+	if !ok || !isOwner(c, owner) {
+		return nil, false, nil
+	}
+	return job, true, nil
+}
+
+func isOwner(c *gin.Context, owner jobs.Owner) bool {
+	if key := callerKey(c); key != "" && key == owner.Key {
+		return true
+	}
+	companyID, ok := middleware.CompanyIDFromContext(c)
+	return ok && owner.CompanyID != 0 && companyID == owner.CompanyID
+}
+
+// trackJob records the owner of a newly submitted job. A session or
+// candidate only gets one run at a time, so its previous job is cancelled if
+// it has not finished; companies may run jobs in parallel.
+func trackJob(c *gin.Context, executorClient *executor.Client, registry *jobs.Registry, jobID string, owner jobs.Owner) {
+	if owner.Key == "" {
+		return
+	}
+
+	previous := registry.Track(jobID, owner)
+	if previous == "" || strings.HasPrefix(owner.Key, companyOwnerPrefix) {
+		return
+	}
+
+	ctx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, supersededCancelTimeout)
+		defer cancel()
+
+		if _, err := executorClient.CancelJob(ctx, previous); err != nil && status.Code(err) != codes.FailedPrecondition {
+			logger.WithContext(ctx).Warn("failed to cancel superseded job",
+				zap.String("job_id", previous),
+				zap.Error(err),
+			)
+			return
+		}
+		registry.Finish(previous)
+	}()
+}
+
+// parseWait reads the wait query parameter, in seconds, capped at maxWait.
+// On an invalid value it writes the response and returns false.
+func parseWait(c *gin.Context, maxWait time.Duration) (time.Duration, bool) {
+	v := c.Query("wait")
+	if v == "" {
+		return 0, true
+	}
+	seconds, err := strconv.Atoi(v)
+	if err != nil || seconds < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid wait: must be a non-negative number of seconds",
+		})
+		return 0, false
+	}
+	return min(time.Duration(seconds)*time.Second, maxWait), true
+}
+
+// respondSubmitted answers a request that created a job. With a wait it
+// holds the request until the job finishes, returning 200 with the results,
+// or 202 with the job ID once the wait runs out.
+func respondSubmitted(c *gin.Context, executorClient *executor.Client, registry *jobs.Registry, resp *executorpb.ExecuteResponse, wait time.Duration) {
+	if wait > 0 {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), wait)
+		job, err := executorClient.AwaitJob(ctx, resp.JobId)
+		cancel()
+		if err != nil {
+			logger.WithContext(c.Request.Context()).Warn("failed to wait for job",
+				zap.String("job_id", resp.JobId),
+				zap.Error(err),
+			)
+		} else if job.Success && executor.IsTerminal(job.Status) {
+			registry.Finish(resp.JobId)
+			c.JSON(http.StatusOK, executor.ToJobStatusResponse(job))
+			return
+		}
+	}
+
+	c.JSON(http.StatusAccepted, model.ExecuteResponse{
+		Success: true,
+		JobID:   resp.JobId,
+		Message: resp.Message,
+	})
+}
+
+// MakeCancelJobHandler creates a handler that cancels a pending or running
+// job. Only the job's submitter or owning company may cancel it; anyone
+// else gets 404.
+func MakeCancelJobHandler(executorClient *executor.Client, registry *jobs.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		jobID := c.Param("job_id")
+
+		_, found, err := ownedJob(c, executorClient, jobID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.CancelJobResponse{
+				Success: false,
+				JobID:   jobID,
+				Error:   "Failed to get job status: " + err.Error(),
+			})
+			return
+		}
+		if !found {
+			c.JSON(http.StatusNotFound, model.CancelJobResponse{
+				Success: false,
+				JobID:   jobID,
+				Error:   "Job not found",
+			})
+			return
+		}
+
+		resp, err := executorClient.CancelJob(c.Request.Context(), jobID)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				c.JSON(http.StatusNotFound, model.CancelJobResponse{
+					Success: false,
+					JobID:   jobID,
+					Error:   "Job not found",
+				})
+			case codes.FailedPrecondition:
+				registry.Finish(jobID)
+				c.JSON(http.StatusConflict, model.CancelJobResponse{
+					Success: false,
+					JobID:   jobID,
+					Error:   "Job has already finished",
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, model.CancelJobResponse{
+					Success: false,
+					JobID:   jobID,
+					Error:   "Failed to cancel job: " + err.Error(),
+				})
+			}
+			return
+		}
+
+		if !resp.Success {
+			c.JSON(http.StatusConflict, model.CancelJobResponse{
+				Success: false,
+				JobID:   jobID,
+				Status:  resp.Status,
+				Error:   resp.Error,
+			})
+			return
+		}
+
+		registry.Finish(jobID)
+		c.JSON(http.StatusOK, model.CancelJobResponse{
+			Success: true,
+			JobID:   jobID,
+			Status:  resp.Status,
+		})
+	}
+}
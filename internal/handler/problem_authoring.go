@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toProblemInput converts a problem request, using visibility when the
+// request leaves it out.
+func toProblemInput(req model.ProblemRequest, visibility string) problems.ProblemInput {
+	if req.Visibility != "" {
+		visibility = req.Visibility
+	}
+
+	tags := make([]string, 0, len(req.Tags))
+	for _, t := range req.Tags {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, strings.ToLower(t))
+		}
+	}
+
+	return problems.ProblemInput{
+		Title:         strings.TrimSpace(req.Title),
+		Description:   req.Description,
+		Difficulty:    req.Difficulty,
+		Tags:          tags,
+		TimeLimitMs:   int32(req.TimeLimitMs),
+		MemoryLimitKB: int32(req.MemoryLimitKB),
+		Visibility:    visibility,
+	}
+}
+
+// authorizeProblemOwner loads the problem and checks it belongs to the
+// authenticated company. On failure it writes the response and returns false.
+// Problems the caller cannot see at all are reported as missing.
+func authorizeProblemOwner(c *gin.Context, problemsClient problems.Service, problemID int32) (int32, bool) {
+	problem, ok := loadOwnedProblem(c, problemsClient, problemID)
+	if !ok {
+		return 0, false
+	}
+	return problem.OwnerCompanyId, true
+}
+
+// loadOwnedProblem is like authorizeProblemOwner, but returns the problem.
+func loadOwnedProblem(c *gin.Context, problemsClient problems.Service, problemID int32) (*problemspb.Problem, bool) {
+	companyID, ok := middleware.CompanyIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "Company authentication required",
+		})
+		return nil, false
+	}
+
+	resp, err := problemsClient.GetProblem(c.Request.Context(), problemID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Problem not found",
+			})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to get problem: " + err.Error(),
+		})
+		return nil, false
+	}
+
+	if !canViewProblem(c, resp.Problem) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Problem not found",
+		})
+		return nil, false
+	}
+	if resp.Problem.OwnerCompanyId != int32(companyID) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error":   "Problem is not owned by this company",
+		})
+		return nil, false
+	}
+
+	return resp.Problem, true
+}
+
+// MakeCreateProblemHandler creates a handler for authoring a company-owned problem
+func MakeCreateProblemHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, ok := middleware.CompanyIDFromContext(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Company authentication required",
+			})
+			return
+		}
+
+		var req model.ProblemRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		resp, err := problemsClient.CreateProblem(c.Request.Context(), int32(companyID), toProblemInput(req, model.ProblemVisibilityPrivate))
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: companyID,
+				Action:    audit.ActionProblemCreate,
+				Outcome:   audit.OutcomeError,
+			})
+			c.JSON(http.StatusInternalServerError, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Failed to create problem: " + err.Error(),
+			})
+			return
+		}
+
+		problem := toProblemResponse(resp.Problem)
+		auditor.Record(c, audit.Event{
+			CompanyID: companyID,
+			Action:    audit.ActionProblemCreate,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   map[string]string{"problem_id": strconv.Itoa(problem.ID)},
+		})
+
+		c.JSON(http.StatusCreated, model.SaveProblemResponse{
+			Success: true,
+			Problem: &problem,
+		})
+	}
+}
+
+// MakeUpdateProblemHandler creates a handler for replacing a company-owned problem
+func MakeUpdateProblemHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		var req model.ProblemRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		current, ok := loadOwnedProblem(c, problemsClient, int32(id))
+		if !ok {
+			return
+		}
+		companyID := current.OwnerCompanyId
+
+		// Visibility is kept as it is unless the request changes it
+		details := map[string]string{"problem_id": strconv.Itoa(id)}
+		resp, err := problemsClient.UpdateProblem(c.Request.Context(), int32(id), companyID, toProblemInput(req, current.Visibility))
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionProblemUpdate,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.SaveProblemResponse{
+				Success: false,
+				Error:   "Failed to update problem: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionProblemUpdate,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		problem := toProblemResponse(resp.Problem)
+		c.JSON(http.StatusOK, model.SaveProblemResponse{
+			Success: true,
+			Problem: &problem,
+		})
+	}
+}
+
+// MakeDeleteProblemHandler creates a handler for deleting a company-owned problem
+func MakeDeleteProblemHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.DeleteProblemResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, int32(id))
+		if !ok {
+			return
+		}
+
+		details := map[string]string{"problem_id": strconv.Itoa(id)}
+		resp, err := problemsClient.DeleteProblem(c.Request.Context(), int32(id), companyID)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionProblemDelete,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.DeleteProblemResponse{
+				Success: false,
+				Error:   "Failed to delete problem: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionProblemDelete,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		c.JSON(http.StatusOK, model.DeleteProblemResponse{
+			Success: true,
+			Message: resp.Message,
+		})
+	}
+}
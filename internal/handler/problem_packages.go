@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/problempkg"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+)
+
+// MakeExportProblemHandler creates a handler that downloads a company-owned
+// problem as a zip problem package
+func MakeExportProblemHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, int32(id))
+		if !ok {
+			return
+		}
+
+		pkg, err := problempkg.Export(c.Request.Context(), problemsClient, int32(id))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "Failed to export problem: " + err.Error(),
+			})
+			return
+		}
+
+		var buf bytes.Buffer
+		if err := pkg.WriteZip(&buf); err != nil {
+			var exportErr *problempkg.ExportError
+			if errors.As(err, &exportErr) {
+				c.JSON(http.StatusUnprocessableEntity, gin.H{
+					"success":     false,
+					"error":       "Problem cannot be exported as a package",
+					"file_errors": exportErr.Files,
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "Failed to export problem: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionProblemExport,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   map[string]string{"problem_id": strconv.Itoa(id)},
+		})
+
+		c.Header("Content-Disposition", `attachment; filename="problem-`+strconv.Itoa(id)+`.zip"`)
+		c.Header("Cache-Control", "private, no-store")
+		c.Data(http.StatusOK, "application/zip", buf.Bytes())
+	}
+}
+
+// MakeImportProblemHandler creates a handler that imports a zip problem
+// package uploaded as the "package" form file. Without a problem ID in the
+// route a new problem is created; with one, that problem and all of its test
+// cases are replaced. Every reference solution must pass every test case.
+func MakeImportProblemHandler(problemsClient problems.Service, verifier *testcases.Verifier, codeValidator *limits.CodeValidator, auditor *audit.Recorder, maxPackageBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var problemID int32
+		if idStr := c.Param("id"); idStr != "" {
+			id, err := strconv.Atoi(idStr)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, model.ImportProblemResponse{
+					Success: false,
+					Error:   "Invalid problem ID: " + err.Error(),
+				})
+				return
+			}
+			problemID = int32(id)
+		}
+
+		data, err := readPackageUpload(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.ImportProblemResponse{
+				Success: false,
+				Error:   "Invalid upload: " + err.Error(),
+			})
+			return
+		}
+
+		pkg, fileErrors := problempkg.ReadZip(data, maxPackageBytes)
+		if len(fileErrors) == 0 {
+			fileErrors = validatePackageCode(codeValidator, pkg)
+		}
+		if len(fileErrors) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, model.ImportProblemResponse{
+				Success:    false,
+				Error:      "Invalid problem package",
+				FileErrors: fileErrors,
+			})
+			return
+		}
+
+		var companyID int32
+		if problemID != 0 {
+			var ok bool
+			if companyID, ok = authorizeProblemOwner(c, problemsClient, problemID); !ok {
+				return
+			}
+		} else {
+			id, ok := middleware.CompanyIDFromContext(c)
+			if !ok {
+				c.JSON(http.StatusUnauthorized, model.ImportProblemResponse{
+					Success: false,
+					Error:   "Company authentication required",
+				})
+				return
+			}
+			companyID = int32(id)
+		}
+
+		fileErrors, err = problempkg.VerifySolutions(c.Request.Context(), verifier, pkg)
+		if err != nil {
+			status := http.StatusBadGateway
+			if errors.Is(err, testcases.ErrVerificationTimeout) {
+				status = http.StatusGatewayTimeout
+			}
+			c.JSON(status, model.ImportProblemResponse{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+		if len(fileErrors) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, model.ImportProblemResponse{
+				Success:    false,
+				Error:      "Reference solutions do not produce the expected output",
+				FileErrors: fileErrors,
+			})
+			return
+		}
+
+		details := map[string]string{"test_cases": strconv.Itoa(len(pkg.TestCases))}
+		if problemID != 0 {
+			details["problem_id"] = strconv.Itoa(int(problemID))
+		}
+
+		problem, testCases, err := problempkg.Import(c.Request.Context(), problemsClient, companyID, problemID, pkg)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionProblemImport,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.ImportProblemResponse{
+				Success: false,
+				Error:   "Failed to import problem: " + err.Error(),
+			})
+			return
+		}
+
+		details["problem_id"] = strconv.Itoa(int(problem.Id))
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionProblemImport,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		status := http.StatusOK
+		if problemID == 0 {
+			status = http.StatusCreated
+		}
+		problemResponse := toProblemResponse(problem)
+		c.JSON(status, model.ImportProblemResponse{
+			Success:   true,
+			Problem:   &problemResponse,
+			TestCases: toTestCaseResponses(testCases),
+		})
+	}
+}
+
+func readPackageUpload(c *gin.Context) ([]byte, error) {
+	fh, err := c.FormFile("package")
+	if err != nil {
+		return nil, errors.New("package file is required")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+// validatePackageCode applies the submission code limits to the package's
+// reference solutions and starter code.
+func validatePackageCode(codeValidator *limits.CodeValidator, pkg *problempkg.Package) []model.FileError {
+	var fileErrors []model.FileError
+	for _, files := range [][]problempkg.SourceFile{pkg.ReferenceSolutions, pkg.StarterCode} {
+		for _, f := range files {
+			if violation := codeValidator.Validate(f.Language, f.Code); violation != nil {
+				fileErrors = append(fileErrors, model.FileError{File: f.Path, Message: violation.Message})
+			}
+		}
+	}
+	return fileErrors
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/diff"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MakeListProblemVersionsHandler creates a handler for listing the versions
+// of a company-owned problem
+func MakeListProblemVersionsHandler(problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.ListProblemVersionsResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		if _, ok := authorizeProblemOwner(c, problemsClient, int32(id)); !ok {
+			return
+		}
+
+		resp, err := problemsClient.ListProblemVersions(c.Request.Context(), int32(id))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.ListProblemVersionsResponse{
+				Success: false,
+				Error:   "Failed to list problem versions: " + err.Error(),
+			})
+			return
+		}
+
+		versions := make([]model.ProblemVersionInfo, len(resp.Versions))
+		for i, v := range resp.Versions {
+			versions[i] = model.ProblemVersionInfo{
+				Version:       int(v.Version),
+				Title:         v.Title,
+				TestCaseCount: int(v.TestCaseCount),
+			}
+			if v.CreatedAt != nil {
+				versions[i].CreatedAt = v.CreatedAt.AsTime().String()
+			}
+		}
+
+		c.JSON(http.StatusOK, model.ListProblemVersionsResponse{
+			Success:  true,
+			Versions: versions,
+		})
+	}
+}
+
+// MakeGetProblemVersionHandler creates a handler for reading one version of
+// a company-owned problem, including its hidden test cases
+func MakeGetProblemVersionHandler(problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.ProblemVersionResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+		version, err := strconv.Atoi(c.Param("version"))
+		if err != nil || version <= 0 {
+			c.JSON(http.StatusBadRequest, model.ProblemVersionResponse{
+				Success: false,
+				Error:   "Invalid version: must be a positive integer",
+			})
+			return
+		}
+
+		if _, ok := authorizeProblemOwner(c, problemsClient, int32(id)); !ok {
+			return
+		}
+
+		v, ok := getProblemVersion(c, problemsClient, int32(id), int32(version))
+		if !ok {
+			return
+		}
+
+		problem := toProblemResponse(v.Problem)
+		c.JSON(http.StatusOK, model.ProblemVersionResponse{
+			Success:   true,
+			Version:   int(v.Version),
+			Problem:   &problem,
+			TestCases: toTestCaseResponses(v.TestCases),
+		})
+	}
+}
+
+// MakeDiffProblemVersionsHandler creates a handler that compares two
+// versions of a company-owned problem, given as the from and to query
+// parameters
+func MakeDiffProblemVersionsHandler(problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.ProblemVersionDiffResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+		from, fromErr := strconv.Atoi(c.Query("from"))
+		to, toErr := strconv.Atoi(c.Query("to"))
+		if fromErr != nil || toErr != nil || from <= 0 || to <= 0 {
+			c.JSON(http.StatusBadRequest, model.ProblemVersionDiffResponse{
+				Success: false,
+				Error:   "Invalid query: from and to must be positive version numbers",
+			})
+			return
+		}
+
+		if _, ok := authorizeProblemOwner(c, problemsClient, int32(id)); !ok {
+			return
+		}
+
+		a, ok := getProblemVersion(c, problemsClient, int32(id), int32(from))
+		if !ok {
+			return
+		}
+		b, ok := getProblemVersion(c, problemsClient, int32(id), int32(to))
+		if !ok {
+			return
+		}
+
+		c.JSON(http.StatusOK, diffProblemVersions(a, b))
+	}
+}
+
+// getProblemVersion loads a problem version. On failure it writes the
+// response and returns false.
+func getProblemVersion(c *gin.Context, problemsClient problems.Service, problemID, version int32) (*problemspb.ProblemVersion, bool) {
+	resp, err := problemsClient.GetProblemVersion(c.Request.Context(), problemID, version)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Problem version " + strconv.Itoa(int(version)) + " not found",
+			})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to get problem version: " + err.Error(),
+		})
+		return nil, false
+	}
+	return resp.Version, true
+}
+
+func diffProblemVersions(a, b *problemspb.ProblemVersion) model.ProblemVersionDiffResponse {
+	resp := model.ProblemVersionDiffResponse{
+		Success: true,
+		From:    int(a.Version),
+		To:      int(b.Version),
+	}
+
+	pa, pb := a.Problem, b.Problem
+	resp.Fields = fieldChanges(
+		[3]string{"title", pa.Title, pb.Title},
+		[3]string{"difficulty", pa.Difficulty, pb.Difficulty},
+		[3]string{"tags", strings.Join(pa.Tags, ","), strings.Join(pb.Tags, ",")},
+		[3]string{"time_limit_ms", strconv.Itoa(int(pa.TimeLimitMs)), strconv.Itoa(int(pb.TimeLimitMs))},
+		[3]string{"memory_limit_kb", strconv.Itoa(int(pa.MemoryLimitKb)), strconv.Itoa(int(pb.MemoryLimitKb))},
+		[3]string{"visibility", pa.Visibility, pb.Visibility},
+	)
+	resp.Description = diff.Lines(pa.Description, pb.Description)
+
+	before := make(map[int32]*problemspb.TestCase, len(a.TestCases))
+	for _, tc := range a.TestCases {
+		before[tc.Id] = tc
+	}
+	for _, tc := range b.TestCases {
+		old, ok := before[tc.Id]
+		if !ok {
+			resp.AddedTestCases = append(resp.AddedTestCases, toTestCaseResponse(tc))
+			continue
+		}
+		delete(before, tc.Id)
+
+		changes := fieldChanges(
+			[3]string{"input", old.Input, tc.Input},
+			[3]string{"expected_output", old.ExpectedOutput, tc.ExpectedOutput},
+			[3]string{"is_hidden", strconv.FormatBool(old.IsHidden), strconv.FormatBool(tc.IsHidden)},
+			[3]string{"weight", strconv.Itoa(int(old.Weight)), strconv.Itoa(int(tc.Weight))},
+			[3]string{"position", strconv.Itoa(int(old.Position)), strconv.Itoa(int(tc.Position))},
+		)
+		if len(changes) > 0 {
+			resp.ChangedTestCases = append(resp.ChangedTestCases, model.TestCaseChange{
+				ID:      int(tc.Id),
+				Changes: changes,
+			})
+		}
+	}
+	// Keep removed cases in their original order.
+	for _, tc := range a.TestCases {
+		if _, removed := before[tc.Id]; removed {
+			resp.RemovedTestCases = append(resp.RemovedTestCases, toTestCaseResponse(tc))
+		}
+	}
+
+	return resp
+}
+
+// fieldChanges returns the {field, from, to} triples whose values differ.
+func fieldChanges(fields ...[3]string) []model.FieldChange {
+	var changes []model.FieldChange
+	for _, f := range fields {
+		if f[1] != f[2] {
+			changes = append(changes, model.FieldChange{Field: f[0], From: f[1], To: f[2]})
+		}
+	}
+	return changes
+}
@@ -3,15 +3,166 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
 	"go-code-runner-microservice/api-gateway/internal/model"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func MakeListProblemsHandler(problemsClient *problems.Client) gin.HandlerFunc {
+const (
+	defaultProblemsPageSize = 20
+	maxProblemsPageSize     = 100
+)
+
+var (
+	problemSortFields = map[string]bool{"id": true, "title": true, "difficulty": true, "created_at": true, "updated_at": true}
+	sortOrders        = map[string]bool{"asc": true, "desc": true}
+)
+
+// parseListProblemsQuery reads paging, filter and sort options from the query
+// string. Difficulties and tags may be repeated or comma separated.
+func parseListProblemsQuery(c *gin.Context) (problems.ListOptions, string) {
+	opts := problems.ListOptions{
+		PageSize:     defaultProblemsPageSize,
+		PageToken:    c.Query("page_token"),
+		Difficulties: splitQueryList(c.QueryArray("difficulty")),
+		Tags:         splitQueryList(c.QueryArray("tag")),
+		Search:       strings.TrimSpace(c.Query("q")),
+		SortBy:       c.DefaultQuery("sort_by", "id"),
+		SortOrder:    strings.ToLower(c.DefaultQuery("sort_order", "asc")),
+	}
+
+	if v := c.Query("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 || size > maxProblemsPageSize {
+			return opts, "page_size must be between 1 and " + strconv.Itoa(maxProblemsPageSize)
+		}
+		opts.PageSize = int32(size)
+	}
+	if !problemSortFields[opts.SortBy] {
+		return opts, "sort_by must be one of id, title, difficulty, created_at, updated_at"
+	}
+	if !sortOrders[opts.SortOrder] {
+		return opts, "sort_order must be asc or desc"
+	}
+
+	return opts, ""
+}
+
+func splitQueryList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
+
+// toProblemResponse converts a proto Problem to its API representation
+func toProblemResponse(p *problemspb.Problem) model.ProblemResponse {
+	problem := model.ProblemResponse{
+		ID:             int(p.Id),
+		Title:          p.Title,
+		Description:    p.Description,
+		Difficulty:     p.Difficulty,
+		Tags:           p.Tags,
+		OwnerCompanyID: int(p.OwnerCompanyId),
+		TimeLimitMs:    int(p.TimeLimitMs),
+		MemoryLimitKB:  int(p.MemoryLimitKb),
+		Visibility:     p.Visibility,
+		Version:        int(p.Version),
+	}
+	if p.CreatedAt != nil {
+		problem.CreatedAt = p.CreatedAt.AsTime().String()
+	}
+	if p.UpdatedAt != nil {
+		problem.UpdatedAt = p.UpdatedAt.AsTime().String()
+	}
+	return problem
+}
+
+// canViewProblem reports whether the caller may see p. Private problems are
+// only visible to the company that owns them.
+func canViewProblem(c *gin.Context, p *problemspb.Problem) bool {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+	return problemVisibleTo(p, int32(companyID))
+}
+
+// ownsProblem reports whether p belongs to the authenticated company.
+func ownsProblem(c *gin.Context, p *problemspb.Problem) bool {
+	companyID, ok := middleware.CompanyIDFromContext(c)
+	return ok && p.OwnerCompanyId != 0 && int32(companyID) == p.OwnerCompanyId
+}
+
+// problemVisibleTo reports whether a company may use p. Public problems are
+// open to every company, private ones only to their owner; companyID zero
+// only sees public problems.
+func problemVisibleTo(p *problemspb.Problem, companyID int32) bool {
+	if p == nil {
+		return false
+	}
+	if p.Visibility != model.ProblemVisibilityPrivate {
+		return true
+	}
+	return companyID != 0 && companyID == p.OwnerCompanyId
+}
+
+// checkProblemViewable checks the caller may see a problem, as
+// MakeGetProblemHandler does. On failure it writes the response and returns
+// false.
+func checkProblemViewable(c *gin.Context, problemsClient problems.Service, problemID int32) bool {
+	companyID, _ := middleware.CompanyIDFromContext(c)
+	return checkProblemsUsable(c, problemsClient, companyID, []int32{problemID})
+}
+
+// checkProblemsUsable checks that a company may build tests from each of
+// problemIDs. On failure it writes the response and returns false; problems
+// the company cannot see are reported as missing.
+func checkProblemsUsable(c *gin.Context, problemsClient problems.Service, companyID int, problemIDs []int32) bool {
+	for _, id := range problemIDs {
+		resp, err := problemsClient.GetProblem(c.Request.Context(), id)
+		if err != nil && status.Code(err) != codes.NotFound {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "Failed to get problem: " + err.Error(),
+			})
+			return false
+		}
+		if err != nil || !problemVisibleTo(resp.Problem, int32(companyID)) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Problem " + strconv.Itoa(int(id)) + " not found",
+			})
+			return false
+		}
+	}
+	return true
+}
+
+func MakeListProblemsHandler(problemsClient problems.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		resp, err := problemsClient.ListProblems(c.Request.Context())
+		opts, invalid := parseListProblemsQuery(c)
+		if invalid != "" {
+			c.JSON(http.StatusBadRequest, model.ListProblemsResponse{
+				Success: false,
+				Error:   "Invalid query: " + invalid,
+			})
+			return
+		}
+		if companyID, ok := middleware.CompanyIDFromContext(c); ok {
+			opts.ViewerCompanyID = int32(companyID)
+			c.Header("Cache-Control", "private, no-cache")
+		}
+
+		resp, err := problemsClient.ListProblems(c.Request.Context(), opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, model.ListProblemsResponse{
 				Success: false,
@@ -22,28 +173,19 @@ func MakeListProblemsHandler(problemsClient *problems.Client) gin.HandlerFunc {
 
 		problemResponses := make([]model.ProblemResponse, len(resp.Problems))
 		for i, p := range resp.Problems {
-			problemResponses[i] = model.ProblemResponse{
-				ID:          int(p.Id),
-				Title:       p.Title,
-				Description: p.Description,
-				Difficulty:  p.Difficulty,
-			}
-			if p.CreatedAt != nil {
-				problemResponses[i].CreatedAt = p.CreatedAt.AsTime().String()
-			}
-			if p.UpdatedAt != nil {
-				problemResponses[i].UpdatedAt = p.UpdatedAt.AsTime().String()
-			}
+			problemResponses[i] = toProblemResponse(p)
 		}
 
 		c.JSON(http.StatusOK, model.ListProblemsResponse{
-			Success:  true,
-			Problems: problemResponses,
+			Success:       true,
+			Problems:      problemResponses,
+			NextPageToken: resp.NextPageToken,
+			TotalCount:    int(resp.TotalCount),
 		})
 	}
 }
 
-func MakeGetProblemHandler(problemsClient *problems.Client) gin.HandlerFunc {
+func MakeGetProblemHandler(problemsClient problems.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
 		id, err := strconv.Atoi(idStr)
@@ -64,19 +206,19 @@ func MakeGetProblemHandler(problemsClient *problems.Client) gin.HandlerFunc {
 			return
 		}
 
-		problem := model.ProblemResponse{
-			ID:          int(resp.Problem.Id),
-			Title:       resp.Problem.Title,
-			Description: resp.Problem.Description,
-			Difficulty:  resp.Problem.Difficulty,
-		}
-		if resp.Problem.CreatedAt != nil {
-			problem.CreatedAt = resp.Problem.CreatedAt.AsTime().String()
+		if !canViewProblem(c, resp.Problem) {
+			c.JSON(http.StatusNotFound, model.GetProblemResponse{
+				Success: false,
+				Error:   "Problem not found",
+			})
+			return
 		}
-		if resp.Problem.UpdatedAt != nil {
-			problem.UpdatedAt = resp.Problem.UpdatedAt.AsTime().String()
+		if resp.Problem.Visibility == model.ProblemVisibilityPrivate {
+			c.Header("Cache-Control", "private, no-cache")
 		}
 
+		problem := toProblemResponse(resp.Problem)
+
 		c.JSON(http.StatusOK, model.GetProblemResponse{
 			Success: true,
 			Problem: problem,
@@ -84,7 +226,9 @@ func MakeGetProblemHandler(problemsClient *problems.Client) gin.HandlerFunc {
 	}
 }
 
-func MakeGetTestCasesByProblemIDHandler(problemsClient *problems.Client) gin.HandlerFunc {
+// MakeGetTestCasesByProblemIDHandler creates a handler that lists a problem's
+// test cases. Hidden test cases are only listed for the owning company
+func MakeGetTestCasesByProblemIDHandler(problemsClient problems.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
 		id, err := strconv.Atoi(idStr)
@@ -96,6 +240,22 @@ func MakeGetTestCasesByProblemIDHandler(problemsClient *problems.Client) gin.Han
 			return
 		}
 
+		problemResp, err := problemsClient.GetProblem(c.Request.Context(), int32(id))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.GetTestCasesByProblemIDResponse{
+				Success: false,
+				Error:   "Failed to get problem: " + err.Error(),
+			})
+			return
+		}
+		if !canViewProblem(c, problemResp.Problem) {
+			c.JSON(http.StatusNotFound, model.GetTestCasesByProblemIDResponse{
+				Success: false,
+				Error:   "Problem not found",
+			})
+			return
+		}
+
 		resp, err := problemsClient.GetTestCasesByProblemID(c.Request.Context(), int32(id))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, model.GetTestCasesByProblemIDResponse{
@@ -105,26 +265,19 @@ func MakeGetTestCasesByProblemIDHandler(problemsClient *problems.Client) gin.Han
 			return
 		}
 
-		testCases := make([]model.TestCaseResponse, len(resp.TestCases))
-		for i, tc := range resp.TestCases {
-			testCases[i] = model.TestCaseResponse{
-				ID:             int(tc.Id),
-				ProblemID:      int(tc.ProblemId),
-				Input:          tc.Input,
-				ExpectedOutput: tc.ExpectedOutput,
-				IsHidden:       tc.IsHidden,
-			}
-			if tc.CreatedAt != nil {
-				testCases[i].CreatedAt = tc.CreatedAt.AsTime().String()
-			}
-			if tc.UpdatedAt != nil {
-				testCases[i].UpdatedAt = tc.UpdatedAt.AsTime().String()
+		testCases := resp.TestCases
+		if !ownsProblem(c, problemResp.Problem) {
+			testCases = nil
+			for _, tc := range resp.TestCases {
+				if !tc.IsHidden {
+					testCases = append(testCases, tc)
+				}
 			}
 		}
 
 		c.JSON(http.StatusOK, model.GetTestCasesByProblemIDResponse{
 			Success:   true,
-			TestCases: testCases,
+			TestCases: toTestCaseResponses(testCases),
 		})
 	}
 }
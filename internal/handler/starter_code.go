@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+)
+
+// defaultStarterCode is served for problems without their own starter code.
+var defaultStarterCode = map[string]string{
+	"go": `package main
+
+import "fmt"
+
+func main() {
+	// Read the input from stdin and print the answer to stdout.
+	fmt.Println()
+}
+`,
+}
+
+// MakeGetStarterCodeHandler creates a handler that returns the starter code
+// of a problem for the language query parameter
+func MakeGetStarterCodeHandler(problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.StarterCodeResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+		language, ok := starterLanguage(c)
+		if !ok {
+			return
+		}
+
+		resp, err := problemsClient.GetProblem(c.Request.Context(), int32(id))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.StarterCodeResponse{
+				Success: false,
+				Error:   "Failed to get problem: " + err.Error(),
+			})
+			return
+		}
+		if !canViewProblem(c, resp.Problem) {
+			c.JSON(http.StatusNotFound, model.StarterCodeResponse{
+				Success: false,
+				Error:   "Problem not found",
+			})
+			return
+		}
+		if resp.Problem.Visibility == model.ProblemVisibilityPrivate {
+			c.Header("Cache-Control", "private, no-cache")
+		}
+
+		writeStarterCode(c, resp.Problem, language)
+	}
+}
+
+// MakeGetTestStarterCodeHandler creates a handler that returns the starter
+// code of a coding test's problem, at the version pinned by the test, to the
+// candidate who started it while it is open. For multi-problem assessments
+// the problem_id query parameter picks the problem
+func MakeGetTestStarterCodeHandler(codingTestsClient *coding_tests.Client, problemsClient problems.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language, ok := starterLanguage(c)
+		if !ok {
+			return
+		}
+
+		problemID, ok := problemIDQuery(c)
+		if !ok {
+			return
+		}
+
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		if !checkTestOpen(c, test, 0) {
+			return
+		}
+		problem := testProblem(c, test, problemID)
+		if problem == nil {
+			return
+		}
+
+		resp, err := problemsClient.GetProblemVersion(c.Request.Context(), problem.ProblemId, problem.ProblemVersion)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.StarterCodeResponse{
+				Success: false,
+				Error:   "Failed to get problem: " + err.Error(),
+			})
+			return
+		}
+		if !problemVisibleTo(resp.Version.Problem, test.CompanyId) {
+			c.JSON(http.StatusNotFound, model.StarterCodeResponse{
+				Success: false,
+				Error:   "Problem not found",
+			})
+			return
+		}
+
+		c.Header("Cache-Control", "private, no-cache")
+		writeStarterCode(c, resp.Version.Problem, language)
+	}
+}
+
+func starterLanguage(c *gin.Context) (string, bool) {
+	language := c.Query("language")
+	if language == "" {
+		c.JSON(http.StatusBadRequest, model.StarterCodeResponse{
+			Success: false,
+			Error:   "Missing query parameter: language",
+		})
+		return "", false
+	}
+	return language, true
+}
+
+// writeStarterCode responds with p's starter code for language. A harness
+// for the language switches the response to harness mode; without any
+// starter code of its own the problem falls back to the default template.
+func writeStarterCode(c *gin.Context, p *problemspb.Problem, language string) {
+	resp := model.StarterCodeResponse{
+		Success:  true,
+		Language: language,
+		Mode:     model.ExecutionModeProgram,
+	}
+
+	found := false
+	for _, f := range p.StarterCode {
+		if f.Language == language {
+			resp.Code = f.Code
+			found = true
+			break
+		}
+	}
+	for _, h := range p.Harnesses {
+		if h.Language == language {
+			resp.Mode = model.ExecutionModeHarness
+			resp.FunctionName = h.FunctionName
+			break
+		}
+	}
+
+	if !found {
+		code, ok := defaultStarterCode[language]
+		if !ok || resp.Mode == model.ExecutionModeHarness {
+			c.JSON(http.StatusNotFound, model.StarterCodeResponse{
+				Success: false,
+				Error:   "No starter code for language " + language,
+			})
+			return
+		}
+		resp.Code = code
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
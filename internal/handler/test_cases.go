@@ -0,0 +1,609 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+)
+
+// toTestCaseResponse converts a proto TestCase to its API representation
+func toTestCaseResponse(tc *problemspb.TestCase) model.TestCaseResponse {
+	testCase := model.TestCaseResponse{
+		ID:             int(tc.Id),
+		ProblemID:      int(tc.ProblemId),
+		Input:          tc.Input,
+		ExpectedOutput: tc.ExpectedOutput,
+		IsHidden:       tc.IsHidden,
+		Weight:         int(tc.Weight),
+		Position:       int(tc.Position),
+	}
+	if tc.CreatedAt != nil {
+		testCase.CreatedAt = tc.CreatedAt.AsTime().String()
+	}
+	if tc.UpdatedAt != nil {
+		testCase.UpdatedAt = tc.UpdatedAt.AsTime().String()
+	}
+	return testCase
+}
+
+func toTestCaseResponses(tcs []*problemspb.TestCase) []model.TestCaseResponse {
+	testCases := make([]model.TestCaseResponse, len(tcs))
+	for i, tc := range tcs {
+		testCases[i] = toTestCaseResponse(tc)
+	}
+	return testCases
+}
+
+func toTestCaseInput(req model.TestCaseRequest) problems.TestCaseInput {
+	in := problems.TestCaseInput{
+		Input:          req.Input,
+		ExpectedOutput: req.ExpectedOutput,
+		IsHidden:       req.IsHidden,
+		Weight:         1,
+	}
+	if req.Weight != nil {
+		in.Weight = int32(*req.Weight)
+	}
+	return in
+}
+
+// parseTestCasePath reads the problem and test case IDs from the route. On
+// failure it writes the response and returns false.
+func parseTestCasePath(c *gin.Context) (int32, int32, bool) {
+	problemID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid problem ID: " + err.Error(),
+		})
+		return 0, 0, false
+	}
+	caseID, err := strconv.Atoi(c.Param("case_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid test case ID: " + err.Error(),
+		})
+		return 0, 0, false
+	}
+	return int32(problemID), int32(caseID), true
+}
+
+// verifyTestCases runs the reference solution against cases and returns the
+// cases it did not reproduce. When the solution cannot be run at all it
+// writes the response and returns false.
+func verifyTestCases(c *gin.Context, verifier *testcases.Verifier, codeValidator *limits.CodeValidator, solution model.ReferenceSolution, cases []problems.TestCaseInput) ([]model.TestResult, bool) {
+	if violation := codeValidator.Validate(solution.Language, solution.Code); violation != nil {
+		c.JSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+			Success:   false,
+			Error:     "Reference solution: " + violation.Message,
+			Violation: violation,
+		})
+		return nil, false
+	}
+
+	failures, err := verifier.Verify(c.Request.Context(), solution, cases)
+	if err != nil {
+		status := http.StatusBadGateway
+		if errors.Is(err, testcases.ErrVerificationTimeout) {
+			status = http.StatusGatewayTimeout
+		}
+		c.JSON(status, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return nil, false
+	}
+	return failures, true
+}
+
+func testCaseAuditDetails(problemID, caseID int32) map[string]string {
+	details := map[string]string{"problem_id": strconv.Itoa(int(problemID))}
+	if caseID != 0 {
+		details["test_case_id"] = strconv.Itoa(int(caseID))
+	}
+	return details
+}
+
+// MakeCreateTestCaseHandler creates a handler for adding a test case to a
+// company-owned problem. The reference solution must pass it first.
+func MakeCreateTestCaseHandler(problemsClient problems.Service, verifier *testcases.Verifier, codeValidator *limits.CodeValidator, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		var req model.SaveTestCaseRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, int32(problemID))
+		if !ok {
+			return
+		}
+
+		in := toTestCaseInput(req.TestCaseRequest)
+		failures, ok := verifyTestCases(c, verifier, codeValidator, *req.ReferenceSolution, []problems.TestCaseInput{in})
+		if !ok {
+			return
+		}
+		if len(failures) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, model.SaveTestCaseResponse{
+				Success:  false,
+				Error:    "Reference solution does not produce the expected output",
+				Failures: failures,
+			})
+			return
+		}
+
+		details := testCaseAuditDetails(int32(problemID), 0)
+		resp, err := problemsClient.CreateTestCase(c.Request.Context(), int32(problemID), companyID, in)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionTestCaseCreate,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Failed to create test case: " + err.Error(),
+			})
+			return
+		}
+
+		testCase := toTestCaseResponse(resp.TestCase)
+		details["test_case_id"] = strconv.Itoa(testCase.ID)
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionTestCaseCreate,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		c.JSON(http.StatusCreated, model.SaveTestCaseResponse{
+			Success:  true,
+			TestCase: &testCase,
+		})
+	}
+}
+
+// MakeUpdateTestCaseHandler creates a handler for replacing a test case of a
+// company-owned problem. The reference solution must pass the new version.
+func MakeUpdateTestCaseHandler(problemsClient problems.Service, verifier *testcases.Verifier, codeValidator *limits.CodeValidator, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, caseID, ok := parseTestCasePath(c)
+		if !ok {
+			return
+		}
+
+		var req model.SaveTestCaseRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, problemID)
+		if !ok {
+			return
+		}
+
+		in := toTestCaseInput(req.TestCaseRequest)
+		failures, ok := verifyTestCases(c, verifier, codeValidator, *req.ReferenceSolution, []problems.TestCaseInput{in})
+		if !ok {
+			return
+		}
+		if len(failures) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, model.SaveTestCaseResponse{
+				Success:  false,
+				Error:    "Reference solution does not produce the expected output",
+				Failures: failures,
+			})
+			return
+		}
+
+		saveTestCase(c, problemsClient, auditor, caseID, problemID, companyID, in)
+	}
+}
+
+// MakePatchTestCaseHandler creates a handler for changing the visibility or
+// weight of a test case. These do not affect the expected output, so no
+// reference solution is needed.
+func MakePatchTestCaseHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, caseID, ok := parseTestCasePath(c)
+		if !ok {
+			return
+		}
+
+		var req model.PatchTestCaseRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, problemID)
+		if !ok {
+			return
+		}
+
+		resp, err := problemsClient.GetTestCasesByProblemID(c.Request.Context(), problemID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Failed to get test cases: " + err.Error(),
+			})
+			return
+		}
+
+		var current *problemspb.TestCase
+		for _, tc := range resp.TestCases {
+			if tc.Id == caseID {
+				current = tc
+				break
+			}
+		}
+		if current == nil {
+			c.JSON(http.StatusNotFound, model.SaveTestCaseResponse{
+				Success: false,
+				Error:   "Test case not found",
+			})
+			return
+		}
+
+		in := problems.TestCaseInput{
+			Input:          current.Input,
+			ExpectedOutput: current.ExpectedOutput,
+			IsHidden:       current.IsHidden,
+			Weight:         current.Weight,
+		}
+		if req.IsHidden != nil {
+			in.IsHidden = *req.IsHidden
+		}
+		if req.Weight != nil {
+			in.Weight = int32(*req.Weight)
+		}
+
+		saveTestCase(c, problemsClient, auditor, caseID, problemID, companyID, in)
+	}
+}
+
+func saveTestCase(c *gin.Context, problemsClient problems.Service, auditor *audit.Recorder, caseID, problemID, companyID int32, in problems.TestCaseInput) {
+	details := testCaseAuditDetails(problemID, caseID)
+	resp, err := problemsClient.UpdateTestCase(c.Request.Context(), caseID, problemID, companyID, in)
+	if err != nil {
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionTestCaseUpdate,
+			Outcome:   audit.OutcomeError,
+			Details:   details,
+		})
+		c.JSON(http.StatusInternalServerError, model.SaveTestCaseResponse{
+			Success: false,
+			Error:   "Failed to update test case: " + err.Error(),
+		})
+		return
+	}
+
+	auditor.Record(c, audit.Event{
+		CompanyID: int(companyID),
+		Action:    audit.ActionTestCaseUpdate,
+		Outcome:   audit.OutcomeSuccess,
+		Details:   details,
+	})
+
+	testCase := toTestCaseResponse(resp.TestCase)
+	c.JSON(http.StatusOK, model.SaveTestCaseResponse{
+		Success:  true,
+		TestCase: &testCase,
+	})
+}
+
+// MakeDeleteTestCaseHandler creates a handler for deleting a test case of a
+// company-owned problem
+func MakeDeleteTestCaseHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, caseID, ok := parseTestCasePath(c)
+		if !ok {
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, problemID)
+		if !ok {
+			return
+		}
+
+		details := testCaseAuditDetails(problemID, caseID)
+		resp, err := problemsClient.DeleteTestCase(c.Request.Context(), caseID, problemID, companyID)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionTestCaseDelete,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.DeleteTestCaseResponse{
+				Success: false,
+				Error:   "Failed to delete test case: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionTestCaseDelete,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		c.JSON(http.StatusOK, model.DeleteTestCaseResponse{
+			Success: true,
+			Message: resp.Message,
+		})
+	}
+}
+
+// MakeReorderTestCasesHandler creates a handler for changing the order in
+// which a problem's test cases are run
+func MakeReorderTestCasesHandler(problemsClient problems.Service, auditor *audit.Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		var req model.ReorderTestCasesRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		ids := make([]int32, len(req.TestCaseIDs))
+		seen := make(map[int]bool, len(req.TestCaseIDs))
+		for i, id := range req.TestCaseIDs {
+			if seen[id] {
+				c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+					Success: false,
+					Error:   "Duplicate test case ID: " + strconv.Itoa(id),
+				})
+				return
+			}
+			seen[id] = true
+			ids[i] = int32(id)
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, int32(problemID))
+		if !ok {
+			return
+		}
+
+		details := testCaseAuditDetails(int32(problemID), 0)
+		resp, err := problemsClient.ReorderTestCases(c.Request.Context(), int32(problemID), companyID, ids)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionTestCaseReorder,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Failed to reorder test cases: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionTestCaseReorder,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		c.JSON(http.StatusOK, model.SaveTestCasesResponse{
+			Success:   true,
+			TestCases: toTestCaseResponses(resp.TestCases),
+		})
+	}
+}
+
+// MakeBulkUploadTestCasesHandler creates a handler for uploading many test
+// cases at once, either as JSON or as a multipart zip archive of NAME.in /
+// NAME.out pairs. The reference solution must pass every case; nothing is
+// saved otherwise.
+func MakeBulkUploadTestCasesHandler(problemsClient problems.Service, verifier *testcases.Verifier, codeValidator *limits.CodeValidator, auditor *audit.Recorder, maxCases int, maxArchiveBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		problemID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Invalid problem ID: " + err.Error(),
+			})
+			return
+		}
+
+		var req model.BulkTestCasesRequest
+		if c.ContentType() == gin.MIMEMultipartPOSTForm {
+			var fileErrors []model.FileError
+			req, fileErrors, err = bindTestCaseArchive(c, maxArchiveBytes)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+					Success: false,
+					Error:   "Invalid upload: " + err.Error(),
+				})
+				return
+			}
+			if len(fileErrors) > 0 {
+				c.JSON(http.StatusUnprocessableEntity, model.SaveTestCasesResponse{
+					Success:    false,
+					Error:      "Invalid test case archive",
+					FileErrors: fileErrors,
+				})
+				return
+			}
+		} else if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Invalid request payload: " + err.Error(),
+			})
+			return
+		}
+
+		if len(req.TestCases) > maxCases {
+			c.JSON(http.StatusUnprocessableEntity, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Too many test cases; at most " + strconv.Itoa(maxCases) + " per upload",
+			})
+			return
+		}
+
+		companyID, ok := authorizeProblemOwner(c, problemsClient, int32(problemID))
+		if !ok {
+			return
+		}
+
+		in := make([]problems.TestCaseInput, len(req.TestCases))
+		for i, tc := range req.TestCases {
+			in[i] = toTestCaseInput(tc)
+		}
+
+		failures, ok := verifyTestCases(c, verifier, codeValidator, *req.ReferenceSolution, in)
+		if !ok {
+			return
+		}
+		if len(failures) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, model.SaveTestCasesResponse{
+				Success:  false,
+				Error:    "Reference solution does not produce the expected output",
+				Failures: failures,
+			})
+			return
+		}
+
+		details := testCaseAuditDetails(int32(problemID), 0)
+		details["count"] = strconv.Itoa(len(in))
+		details["replace_existing"] = strconv.FormatBool(req.ReplaceExisting)
+		resp, err := problemsClient.BulkCreateTestCases(c.Request.Context(), int32(problemID), companyID, in, req.ReplaceExisting)
+		if err != nil {
+			auditor.Record(c, audit.Event{
+				CompanyID: int(companyID),
+				Action:    audit.ActionTestCaseBulkCreate,
+				Outcome:   audit.OutcomeError,
+				Details:   details,
+			})
+			c.JSON(http.StatusInternalServerError, model.SaveTestCasesResponse{
+				Success: false,
+				Error:   "Failed to save test cases: " + err.Error(),
+			})
+			return
+		}
+
+		auditor.Record(c, audit.Event{
+			CompanyID: int(companyID),
+			Action:    audit.ActionTestCaseBulkCreate,
+			Outcome:   audit.OutcomeSuccess,
+			Details:   details,
+		})
+
+		c.JSON(http.StatusCreated, model.SaveTestCasesResponse{
+			Success:   true,
+			TestCases: toTestCaseResponses(resp.TestCases),
+		})
+	}
+}
+
+// bindTestCaseArchive reads a multipart bulk upload: an "archive" zip file,
+// the reference solution in "reference_language" and "reference_code", and
+// optional "is_hidden", "weight" (default 1) and "replace_existing" fields
+// applied to every case.
+func bindTestCaseArchive(c *gin.Context, maxArchiveBytes int64) (model.BulkTestCasesRequest, []model.FileError, error) {
+	var req model.BulkTestCasesRequest
+
+	language, code := c.PostForm("reference_language"), c.PostForm("reference_code")
+	if language == "" || code == "" {
+		return req, nil, errors.New("reference_language and reference_code are required")
+	}
+	req.ReferenceSolution = &model.ReferenceSolution{Language: language, Code: code}
+
+	var err error
+	var isHidden bool
+	if v := c.PostForm("is_hidden"); v != "" {
+		if isHidden, err = strconv.ParseBool(v); err != nil {
+			return req, nil, errors.New("is_hidden must be a boolean")
+		}
+	}
+	weight := 1
+	if v := c.PostForm("weight"); v != "" {
+		if weight, err = strconv.Atoi(v); err != nil || weight < 0 || weight > 1000 {
+			return req, nil, errors.New("weight must be between 0 and 1000")
+		}
+	}
+	if v := c.PostForm("replace_existing"); v != "" {
+		if req.ReplaceExisting, err = strconv.ParseBool(v); err != nil {
+			return req, nil, errors.New("replace_existing must be a boolean")
+		}
+	}
+
+	fh, err := c.FormFile("archive")
+	if err != nil {
+		return req, nil, errors.New("archive file is required")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return req, nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return req, nil, err
+	}
+
+	cases, fileErrors := testcases.ParseZip(data, maxArchiveBytes)
+	if len(fileErrors) > 0 {
+		return req, fileErrors, nil
+	}
+
+	req.TestCases = make([]model.TestCaseRequest, len(cases))
+	for i, tc := range cases {
+		req.TestCases[i] = model.TestCaseRequest{
+			Input:          tc.Input,
+			ExpectedOutput: tc.ExpectedOutput,
+			IsHidden:       isHidden,
+			Weight:         &weight,
+		}
+	}
+	return req, nil, nil
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/testtimer"
+)
+
+// checkTestOpen requires test to be unsubmitted and within its time limit,
+// extended by grace. On failure it writes the response and returns false.
+func checkTestOpen(c *gin.Context, test *codingtestspb.CodingTest, grace time.Duration) bool {
+	if test.CompletedAt != nil {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error":   "Test has already been submitted",
+		})
+		return false
+	}
+	if deadline, ok := testtimer.Deadline(test); ok && time.Now().After(deadline.Add(grace)) {
+		c.JSON(http.StatusGone, gin.H{
+			"success": false,
+			"error":   "Test time is over",
+		})
+		return false
+	}
+	return true
+}
+
+// scheduleTest arranges for a started test to be submitted when its time
+// runs out and the submission grace period has passed.
+func scheduleTest(timer *testtimer.Scheduler, test *codingtestspb.CodingTest) {
+	if test.StartedAt == nil || test.CompletedAt != nil {
+		return
+	}
+	if deadline, ok := testtimer.Deadline(test); ok {
+		timer.Schedule(test.Id, deadline)
+	}
+}
+
+// MakeTestTimeRemainingHandler creates a handler that reports how long the
+// candidate has left, measured by the server's clock
+func MakeTestTimeRemainingHandler(codingTestsClient *coding_tests.Client, timer *testtimer.Scheduler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		test := loadTest(c, codingTestsClient)
+		if test == nil {
+			return
+		}
+		if !checkCandidate(c, test) {
+			return
+		}
+		scheduleTest(timer, test)
+
+		now := time.Now().UTC()
+		resp := model.TimeRemainingResponse{
+			Success:    true,
+			Status:     test.Status,
+			ServerTime: now,
+		}
+		if test.StartedAt != nil {
+			startedAt := test.StartedAt.AsTime()
+			resp.StartedAt = &startedAt
+		}
+		if deadline, ok := testtimer.Deadline(test); ok {
+			resp.Deadline = &deadline
+			if test.CompletedAt == nil && deadline.After(now) {
+				resp.RemainingSeconds = int(deadline.Sub(now) / time.Second)
+			}
+		}
+
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, resp)
+	}
+}
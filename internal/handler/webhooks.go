@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/webhook"
+	"go.uber.org/zap"
+)
+
+// JobCallbacks watches jobs submitted with a callback URL and sends a
+// job.finished webhook once they are done, signed with the submitting
+// company's callback secret.
+type JobCallbacks struct {
+	executor   *executor.Client
+	registry   *jobs.Registry
+	dispatcher *webhook.Dispatcher
+	secret     []byte
+	jobTimeout time.Duration
+}
+
+// NewJobCallbacks returns nil when secret, the key company callback secrets
+// are derived from, is empty, which disables callbacks.
+func NewJobCallbacks(executorClient *executor.Client, registry *jobs.Registry, dispatcher *webhook.Dispatcher, secret string, jobTimeout time.Duration) *JobCallbacks {
+	if secret == "" {
+		return nil
+	}
+	return &JobCallbacks{
+		executor:   executorClient,
+		registry:   registry,
+		dispatcher: dispatcher,
+		secret:     []byte(secret),
+		jobTimeout: jobTimeout,
+	}
+}
+
+// checkCallbackURL validates the callback URL of a request, which only
+// companies may set. On failure it writes the response and returns false.
+func (jc *JobCallbacks) checkCallbackURL(c *gin.Context, callbackURL string) bool {
+	if callbackURL == "" {
+		return true
+	}
+	if _, ok := middleware.CompanyIDFromContext(c); !ok {
+		c.JSON(http.StatusUnauthorized, model.ExecuteResponse{
+			Success: false,
+			Error:   "callback_url requires company authentication",
+		})
+		return false
+	}
+	if jc == nil {
+		c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+			Success: false,
+			Error:   "Webhooks are not configured",
+		})
+		return false
+	}
+	if !isWebhookURL(callbackURL) {
+		c.JSON(http.StatusBadRequest, model.ExecuteResponse{
+			Success: false,
+			Error:   "callback_url must be an absolute http or https URL",
+		})
+		return false
+	}
+	return true
+}
+
+func isWebhookURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// watch waits in the background for jobID to finish and then delivers it
+// to callbackURL.
+func (jc *JobCallbacks) watch(c *gin.Context, callbackURL, jobID string) {
+	if jc == nil || callbackURL == "" {
+		return
+	}
+	companyID, _ := middleware.CompanyIDFromContext(c)
+
+	ctx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		waitCtx, cancel := context.WithTimeout(ctx, jc.jobTimeout)
+		job, err := jc.executor.WaitForJob(waitCtx, jobID)
+		cancel()
+		if err != nil || !job.Success {
+			logger.WithContext(ctx).Warn("gave up waiting for job callback",
+				zap.String("job_id", jobID),
+				zap.Error(err),
+			)
+			return
+		}
+		jc.registry.Finish(jobID)
+
+		payload := executor.ToJobStatusResponse(job)
+		msg := webhook.Message{
+			CompanyID: companyID,
+			URL:       callbackURL,
+			Event:     webhook.EventJobFinished,
+			JobID:     jobID,
+			Data:      payload,
+		}
+		if _, err := jc.dispatcher.Send(ctx, msg, webhook.CompanySecret(jc.secret, companyID)); err != nil {
+			logger.WithContext(ctx).Error("failed to send job callback",
+				zap.String("job_id", jobID),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// MakeListWebhookDeliveriesHandler creates a handler that lists the
+// company's recent webhook deliveries, optionally filtered by job_id,
+// test_id or status
+func MakeListWebhookDeliveriesHandler(log *webhook.Log) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, _ := middleware.CompanyIDFromContext(c)
+
+		deliveries, err := log.List(c.Request.Context(), webhook.Filter{
+			CompanyID: companyID,
+			JobID:     c.Query("job_id"),
+			TestID:    c.Query("test_id"),
+			Status:    c.Query("status"),
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.ListWebhookDeliveriesResponse{
+				Success: false,
+				Error:   "Failed to list deliveries: " + err.Error(),
+			})
+			return
+		}
+		resp := model.ListWebhookDeliveriesResponse{
+			Success:    true,
+			Deliveries: make([]model.WebhookDelivery, len(deliveries)),
+		}
+		for i, d := range deliveries {
+			resp.Deliveries[i] = toWebhookDelivery(d)
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// MakeGetWebhookDeliveryHandler creates a handler that returns one of the
+// company's webhook deliveries with all of its attempts
+func MakeGetWebhookDeliveryHandler(log *webhook.Log) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, _ := middleware.CompanyIDFromContext(c)
+
+		d, ok, err := log.Get(c.Request.Context(), companyID, c.Param("delivery_id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, model.WebhookDeliveryResponse{
+				Success: false,
+				Error:   "Failed to get delivery: " + err.Error(),
+			})
+			return
+		}
+		if !ok {
+			c.JSON(http.StatusNotFound, model.WebhookDeliveryResponse{
+				Success: false,
+				Error:   "Delivery not found",
+			})
+			return
+		}
+
+		delivery := toWebhookDelivery(d)
+		c.JSON(http.StatusOK, model.WebhookDeliveryResponse{
+			Success:  true,
+			Delivery: &delivery,
+		})
+	}
+}
+
+func toWebhookDelivery(d webhook.Delivery) model.WebhookDelivery {
+	out := model.WebhookDelivery{
+		ID:           d.ID,
+		Event:        d.Event,
+		WebhookID:    d.WebhookID,
+		JobID:        d.JobID,
+		TestID:       d.TestID,
+		RedeliveryOf: d.RedeliveryOf,
+		URL:          d.URL,
+		Status:       d.Status,
+		CreatedAt:    d.CreatedAt,
+		Attempts:     make([]model.WebhookAttempt, len(d.Attempts)),
+	}
+	for i, a := range d.Attempts {
+		out.Attempts[i] = model.WebhookAttempt{
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			DurationMs: a.Duration.Milliseconds(),
+		}
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package idempotency
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// State describes what the caller of Begin should do with a request.
+type State int
+
+const (
+	// StateNew means the caller owns the key and must call Complete or Abort.
+	StateNew State = iota
+	// StateReplay means a finished response is available for the key.
+	StateReplay
+	// StateInFlight means another request with the same key is still running.
+	StateInFlight
+	// StateMismatch means the key was used before with a different request.
+	StateMismatch
+)
+
+// Response is the stored outcome of the first request made with a key.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+type entry struct {
+	fingerprint string
+	response    *Response
+	done        chan struct{}
+	expiresAt   time.Time
+}
+
+// Store keeps responses for idempotency keys in memory for a fixed TTL.
+type Store struct {
+	mu        sync.Mutex
+	entries   map[string]*entry
+	ttl       time.Duration
+	lastSweep time.Time
+}
+
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		entries:   make(map[string]*entry),
+		ttl:       ttl,
+		lastSweep: time.Now(),
+	}
+}
+
+// Begin claims key for a request whose body hashes to fingerprint. When the
+// state is StateReplay the stored response is returned; when it is
+// StateInFlight the returned channel is closed once the running request
+// finishes.
+func (s *Store) Begin(key, fingerprint string) (State, *Response, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	e, ok := s.entries[key]
+	if ok && now.After(e.expiresAt) {
+		delete(s.entries, key)
+		ok = false
+	}
+
+	if !ok {
+		s.entries[key] = &entry{
+			fingerprint: fingerprint,
+			done:        make(chan struct{}),
+			expiresAt:   now.Add(s.ttl),
+		}
+		return StateNew, nil, nil
+	}
+
+	if e.fingerprint != fingerprint {
+		return StateMismatch, nil, nil
+	}
+	if e.response == nil {
+		return StateInFlight, nil, e.done
+	}
+	return StateReplay, e.response, nil
+}
+
+// Complete stores the response for key and releases waiting duplicates.
+func (s *Store) Complete(key string, resp *Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok || e.response != nil {
+		return
+	}
+	e.response = resp
+	e.expiresAt = time.Now().Add(s.ttl)
+	close(e.done)
+}
+
+// Abort forgets key so that a retry is processed from scratch. It is used when
+// the first attempt failed in a way worth retrying.
+func (s *Store) Abort(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok || e.response != nil {
+		return
+	}
+	delete(s.entries, key)
+	close(e.done)
+}
+
+func (s *Store) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.ttl/2 {
+		return
+	}
+	s.lastSweep = now
+	for key, e := range s.entries {
+		if e.response != nil && now.After(e.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
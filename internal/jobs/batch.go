@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Batch is a set of jobs submitted together, e.g. to re-grade past
+// submissions after a problem's test cases changed.
+type Batch struct {
+	ID        string
+	Owner     Owner
+	CreatedAt time.Time
+	// Items are in submission order. An item whose submission failed has
+	// no JobID and says why in Error.
+	Items []BatchItem
+}
+
+type BatchItem struct {
+	JobID string
+	Error string
+}
+
+func NewBatchID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// TrackBatch stores b for the registry's TTL. Its jobs are tracked
+// separately with Track.
+func (r *Registry) TrackBatch(b *Batch) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.sweep(now)
+	r.batches[b.ID] = &batchEntry{batch: b, expiresAt: now.Add(r.ttl)}
+}
+
+// Batch returns the batch with the given ID, if it is known.
+func (r *Registry) Batch(id string) (*Batch, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.batches[id]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+	return e.batch, true
+}
+
+type batchEntry struct {
+	batch     *Batch
+	expiresAt time.Time
+}
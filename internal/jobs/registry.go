@@ -0,0 +1,97 @@
+// Package jobs describes who owns execution jobs, so that only the submitter
+// and the company a job belongs to can read or act on it later. Owners are
+// stored with the job by the executor; the Registry only tracks what one
+// gateway replica needs locally.
+package jobs
+
+import (
+	"sync"
+	"time"
+)
+
+// Owner is who may read and cancel a job: the submitter identified by Key,
+// and CompanyID when it is non-zero.
+type Owner struct {
+	// Key identifies the submitting company or session.
+	Key string
+	// CompanyID is the company the job belongs to, e.g. the company that
+	// owns the coding test a candidate ran the job for.
+	CompanyID int
+}
+
+type entry struct {
+	owner     Owner
+	finished  bool
+	expiresAt time.Time
+}
+
+// Registry keeps, in memory and for a fixed TTL, the most recent job each
+// owner submitted through this replica, so that a superseded run can be
+// cancelled, and the batches submitted through it.
+type Registry struct {
+	mu        sync.Mutex
+	jobs      map[string]*entry
+	latest    map[string]string
+	batches   map[string]*batchEntry
+	ttl       time.Duration
+	lastSweep time.Time
+}
+
+func NewRegistry(ttl time.Duration) *Registry {
+	return &Registry{
+		jobs:      make(map[string]*entry),
+		latest:    make(map[string]string),
+		batches:   make(map[string]*batchEntry),
+		ttl:       ttl,
+		lastSweep: time.Now(),
+	}
+}
+
+// Track records owner as the owner of jobID. It returns the previous job
+// with the same owner key when that one has not been seen to finish, or "".
+func (r *Registry) Track(jobID string, owner Owner) (previous string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.sweep(now)
+
+	if prev, ok := r.latest[owner.Key]; ok {
+		if e, ok := r.jobs[prev]; ok && !e.finished {
+			previous = prev
+		}
+	}
+	r.jobs[jobID] = &entry{owner: owner, expiresAt: now.Add(r.ttl)}
+	r.latest[owner.Key] = jobID
+	return previous
+}
+
+// Finish marks jobID as having reached a terminal status.
+func (r *Registry) Finish(jobID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.jobs[jobID]; ok {
+		e.finished = true
+	}
+}
+
+func (r *Registry) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < r.ttl/2 {
+		return
+	}
+	r.lastSweep = now
+	for id, e := range r.jobs {
+		if now.After(e.expiresAt) {
+			delete(r.jobs, id)
+			if r.latest[e.owner.Key] == id {
+				delete(r.latest, e.owner.Key)
+			}
+		}
+	}
+	for id, e := range r.batches {
+		if now.After(e.expiresAt) {
+			delete(r.batches, id)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package limits
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+const (
+	LimitBodyBytes = "max_body_bytes"
+	LimitCodeBytes = "max_code_bytes"
+	LimitCodeLines = "max_code_lines"
+	LimitUTF8      = "valid_utf8"
+	LimitNullByte  = "no_null_bytes"
+)
+
+// CodeLimit caps the size of submitted source code. Zero disables a check.
+type CodeLimit struct {
+	MaxBytes int
+	MaxLines int
+}
+
+// CodeValidator checks submitted source code against per-language limits. A
+// nil *CodeValidator still rejects invalid UTF-8 and null bytes.
+type CodeValidator struct {
+	defaults  CodeLimit
+	languages map[string]CodeLimit
+}
+
+func NewCodeValidator(defaults CodeLimit, languages map[string]CodeLimit) *CodeValidator {
+	return &CodeValidator{
+		defaults:  defaults,
+		languages: languages,
+	}
+}
+
+func (v *CodeValidator) limitFor(language string) CodeLimit {
+	if v == nil {
+		return CodeLimit{}
+	}
+	if l, ok := v.languages[strings.ToLower(language)]; ok {
+		return l
+	}
+	return v.defaults
+}
+
+// Validate returns the first limit the code violates, or nil if it is
+// acceptable.
+func (v *CodeValidator) Validate(language, code string) *model.LimitViolation {
+	if !utf8.ValidString(code) {
+		return &model.LimitViolation{
+			Limit:   LimitUTF8,
+			Message: "Code must be valid UTF-8",
+		}
+	}
+
+	if i := strings.IndexByte(code, 0); i >= 0 {
+		return &model.LimitViolation{
+			Limit:   LimitNullByte,
+			Line:    strings.Count(code[:i], "\n") + 1,
+			Message: "Code must not contain null bytes",
+		}
+	}
+
+	limit := v.limitFor(language)
+
+	if limit.MaxBytes > 0 && len(code) > limit.MaxBytes {
+		return &model.LimitViolation{
+			Limit:   LimitCodeBytes,
+			Max:     limit.MaxBytes,
+			Actual:  len(code),
+			Message: fmt.Sprintf("Code exceeds %d bytes for language %q", limit.MaxBytes, language),
+		}
+	}
+
+	if limit.MaxLines > 0 {
+		lines := strings.Count(code, "\n")
+		if !strings.HasSuffix(code, "\n") {
+			lines++
+		}
+		if lines > limit.MaxLines {
+			return &model.LimitViolation{
+				Limit:   LimitCodeLines,
+				Max:     limit.MaxLines,
+				Actual:  lines,
+				Message: fmt.Sprintf("Code exceeds %d lines for language %q", limit.MaxLines, language),
+			}
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"go-code-runner-microservice/api-gateway/internal/redact"
 )
 
 type contextKey string
@@ -26,6 +28,9 @@ type Config struct {
 	Level       string `yaml:"level"`
 	Environment string `yaml:"environment"`
 	ServiceName string `yaml:"service_name"`
+
+	// Redactor, when set, scrubs sensitive values from every log entry.
+	Redactor *redact.Redactor `yaml:"-"`
 }
 
 func Initialize(cfg Config) error {
@@ -69,6 +74,7 @@ func Initialize(cfg Config) error {
 		zap.AddCaller(),
 		zap.AddCallerSkip(1),
 		zap.AddStacktrace(zap.ErrorLevel),
+		zap.WrapCore(cfg.Redactor.Core),
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const AdminTokenHeader = "X-Admin-Token"
+
+// AdminAuthMiddleware guards operator endpoints with a shared token. When no
+// token is configured the endpoints are disabled entirely.
+func AdminAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "Admin endpoints are disabled",
+			})
+			return
+		}
+
+		provided := c.GetHeader(AdminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "Invalid admin token",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
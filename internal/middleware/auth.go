@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
+	"go.uber.org/zap"
+)
+
+const (
+	companyIDKey    = "company_id"
+	companyEmailKey = "company_email"
+)
+
+// CompanyAuthMiddleware requires a company bearer token and stores the
+// authenticated company ID on the context for downstream handlers.
+func CompanyAuthMiddleware(client *company_auth.Client) gin.HandlerFunc {
+	return companyAuth(client, true)
+}
+
+// OptionalCompanyAuthMiddleware authenticates the company when a bearer token
+// is present and lets anonymous requests through. An invalid token is still
+// rejected.
+func OptionalCompanyAuthMiddleware(client *company_auth.Client) gin.HandlerFunc {
+	return companyAuth(client, false)
+}
+
+func companyAuth(client *company_auth.Client, required bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c.GetHeader("Authorization"))
+		if token == "" {
+			if !required {
+				c.Next()
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "Missing bearer token",
+			})
+			return
+		}
+
+		resp, err := client.ValidateToken(c.Request.Context(), token)
+		if err != nil {
+			logger.WithContext(c.Request.Context()).Error("failed to validate company token", zap.Error(err))
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "Failed to validate token",
+			})
+			return
+		}
+
+		if !resp.Success || resp.Company == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "Invalid or expired token",
+			})
+			return
+		}
+
+		c.Set(companyIDKey, int(resp.Company.Id))
+		c.Set(companyEmailKey, resp.Company.Email)
+		c.Next()
+	}
+}
+
+// CompanyIDFromContext returns the company authenticated by
+// CompanyAuthMiddleware, if any.
+func CompanyIDFromContext(c *gin.Context) (int, bool) {
+	v, ok := c.Get(companyIDKey)
+	if !ok {
+		return 0, false
+	}
+	id, ok := v.(int)
+	return id, ok
+}
+
+// CompanyEmailFromContext returns the email of the company authenticated by
+// CompanyAuthMiddleware, or "" if there is none. Audit events use it as the
+// actor.
+func CompanyEmailFromContext(c *gin.Context) string {
+	return c.GetString(companyEmailKey)
+}
+
+func bearerToken(header string) string {
+	const prefix = "bearer "
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
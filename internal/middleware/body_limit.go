@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/limits"
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+// BodyLimitMiddleware rejects request bodies larger than the limit for the
+// matched route with 413. routeLimits is keyed by "METHOD /route/:pattern";
+// routes without an entry use defaultLimit. Accepted bodies are buffered so
+// later middleware can read them again, and JSON bodies must be valid UTF-8.
+func BodyLimitMiddleware(defaultLimit int64, routeLimits map[string]int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		limit := defaultLimit
+		if l, ok := routeLimits[c.Request.Method+" "+c.FullPath()]; ok {
+			limit = l
+		}
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			abortBodyTooLarge(c, limit, c.Request.ContentLength)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limit))
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				abortBodyTooLarge(c, limit, 0)
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusBadRequest, model.LimitErrorResponse{
+				Success: false,
+				Error:   "Failed to read request body",
+			})
+			return
+		}
+		// encoding/json silently replaces invalid UTF-8, so it has to be
+		// caught on the raw bytes.
+		if c.ContentType() == gin.MIMEJSON && !utf8.Valid(body) {
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, model.LimitErrorResponse{
+				Success: false,
+				Error:   "Request body must be valid UTF-8",
+				Violation: &model.LimitViolation{
+					Limit:   limits.LimitUTF8,
+					Message: "Request body must be valid UTF-8",
+				},
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		c.Next()
+	}
+}
+
+func abortBodyTooLarge(c *gin.Context, limit, actual int64) {
+	c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, model.LimitErrorResponse{
+		Success: false,
+		Error:   "Request body too large",
+		Violation: &model.LimitViolation{
+			Limit:   limits.LimitBodyBytes,
+			Max:     int(limit),
+			Actual:  int(actual),
+			Message: fmt.Sprintf("Request body exceeds %d bytes", limit),
+		},
+	})
+}
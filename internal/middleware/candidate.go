@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/candidate"
+)
+
+const (
+	CandidateTokenHeader = "X-Candidate-Token"
+	candidateClaimsKey   = "candidate_claims"
+)
+
+// CandidateAuthMiddleware requires the candidate token issued when the test
+// in the test_id path parameter was started.
+func CandidateAuthMiddleware(issuer *candidate.Issuer) gin.HandlerFunc {
+	return candidateAuth(issuer, true, true)
+}
+
+// OptionalCandidateAuthMiddleware checks the candidate token when one is
+// present and lets requests without one through. An invalid or expired token
+// is still rejected.
+func OptionalCandidateAuthMiddleware(issuer *candidate.Issuer) gin.HandlerFunc {
+	return candidateAuth(issuer, false, true)
+}
+
+// OptionalCandidateTokenMiddleware is like OptionalCandidateAuthMiddleware
+// for routes without a test_id path parameter, such as job status: a token
+// for any test is accepted.
+func OptionalCandidateTokenMiddleware(issuer *candidate.Issuer) gin.HandlerFunc {
+	return candidateAuth(issuer, false, false)
+}
+
+func candidateAuth(issuer *candidate.Issuer, required, matchTest bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(CandidateTokenHeader)
+		if token == "" {
+			if !required {
+				c.Next()
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "Missing candidate token",
+			})
+			return
+		}
+
+		claims, err := issuer.Parse(token)
+		if err != nil {
+			msg := "Invalid candidate token"
+			if errors.Is(err, candidate.ErrExpiredToken) {
+				msg = "Candidate token has expired"
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   msg,
+			})
+			return
+		}
+		if matchTest && claims.TestID != c.Param("test_id") {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "Candidate token is for another test",
+			})
+			return
+		}
+
+		c.Set(candidateClaimsKey, claims)
+		c.Next()
+	}
+}
+
+// CandidateFromContext returns the claims of the candidate token checked by
+// CandidateAuthMiddleware, if any.
+func CandidateFromContext(c *gin.Context) (candidate.Claims, bool) {
+	v, ok := c.Get(candidateClaimsKey)
+	if !ok {
+		return candidate.Claims{}, false
+	}
+	claims, ok := v.(candidate.Claims)
+	return claims, ok
+}
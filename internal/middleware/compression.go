@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// compressWriter buffers the response until minSize bytes have been written
+// and only then commits to compressing it, so small payloads go out as-is.
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	minSize  int
+	buf      bytes.Buffer
+	encoder  io.WriteCloser
+	bypass   bool
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.bypass {
+		return w.ResponseWriter.Write(b)
+	}
+	if w.encoder != nil {
+		return w.encoder.Write(b)
+	}
+
+	if w.buf.Len() == 0 && !compressible(w.ResponseWriter) {
+		w.bypass = true
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf.Write(b)
+	if w.buf.Len() < w.minSize {
+		return len(b), nil
+	}
+
+	w.start()
+	if _, err := w.encoder.Write(w.buf.Bytes()); err != nil {
+		return 0, err
+	}
+	w.buf.Reset()
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) start() {
+	h := w.Header()
+	h.Set("Content-Encoding", w.encoding)
+	h.Del("Content-Length")
+
+	switch w.encoding {
+	case encodingBrotli:
+		w.encoder = brotli.NewWriterLevel(w.ResponseWriter, brotli.DefaultCompression)
+	default:
+		w.encoder, _ = gzip.NewWriterLevel(w.ResponseWriter, gzip.DefaultCompression)
+	}
+}
+
+// finish flushes whatever is still pending once the handler chain is done.
+func (w *compressWriter) finish() {
+	if w.encoder != nil {
+		_ = w.encoder.Close()
+		return
+	}
+	if w.buf.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+}
+
+// encodingFor returns the Content-Encoding a response of size bytes will be
+// sent with, going by the headers set so far, or "" if it is sent as-is.
+func (w *compressWriter) encodingFor(size int) string {
+	if w.bypass || size < w.minSize || !compressible(w.ResponseWriter) {
+		return ""
+	}
+	return w.encoding
+}
+
+func compressible(w gin.ResponseWriter) bool {
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	if w.Header().Get("Content-Encoding") != "" {
+		return false
+	}
+	ct := w.Header().Get("Content-Type")
+	return strings.HasPrefix(ct, "application/json") || strings.HasPrefix(ct, "text/")
+}
+
+// CompressionMiddleware compresses JSON and text responses with brotli or gzip,
+// whichever the client prefers in Accept-Encoding. Responses smaller than
+// minSize bytes are sent uncompressed.
+func CompressionMiddleware(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, minSize: minSize}
+		c.Writer = cw
+		defer cw.finish()
+
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks the supported encoding with the highest q-value,
+// preferring brotli on ties.
+func negotiateEncoding(header string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		switch name {
+		case encodingBrotli:
+			if q >= bestQ {
+				best, bestQ = encodingBrotli, q
+			}
+		case encodingGzip, "*":
+			if q > bestQ {
+				best, bestQ = encodingGzip, q
+			}
+		}
+	}
+	return best
+}
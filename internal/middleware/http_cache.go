@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// HTTPCacheMiddleware adds strong ETags and Cache-Control headers to GET
+// responses of the routes listed in policies, keyed by route pattern, and
+// answers matching If-None-Match requests with 304. The ETag of a response
+// that CompressionMiddleware compresses names the encoding, so that every
+// encoding of a body has its own. Other routes pass through untouched.
+func HTTPCacheMiddleware(policies map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cacheControl, ok := policies[c.FullPath()]
+		if !ok || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		bw := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = bw
+
+		c.Next()
+
+		c.Writer = original
+
+		if bw.status != http.StatusOK {
+			original.WriteHeader(bw.status)
+			_, _ = original.Write(bw.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(bw.body.Bytes())
+		etag := hex.EncodeToString(sum[:16])
+		if cw, ok := original.(*compressWriter); ok {
+			if encoding := cw.encodingFor(bw.body.Len()); encoding != "" {
+				etag += "-" + encoding
+			}
+		}
+		etag = `"` + etag + `"`
+
+		h := original.Header()
+		h.Set("ETag", etag)
+		// Handlers may already have chosen a stricter policy, e.g. for
+		// private content.
+		if cacheControl != "" && h.Get("Cache-Control") == "" {
+			h.Set("Cache-Control", cacheControl)
+		}
+
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			h.Del("Content-Type")
+			h.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(bw.body.Bytes())
+	}
+}
+
+// etagMatches implements the weak comparison If-None-Match calls for.
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/idempotency"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+type recordingWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware replays the stored response of a POST request when it
+// is retried with the same Idempotency-Key header. Keys are scoped to the
+// authenticated caller and the request path, so the middleware goes after the
+// route's auth middlewares; requests without an authenticated caller are not
+// deduplicated, since their responses could be replayed to anyone. Reusing a
+// key with a different body is rejected with 409, and a duplicate that
+// arrives while the first request is still running waits up to wait for it
+// to finish.
+func IdempotencyMiddleware(store *idempotency.Store, wait time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		principal, ok := idempotencyPrincipal(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Idempotency-Key must be at most 255 characters",
+			})
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error":   "Failed to read request body",
+				})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		sum := sha256.Sum256(body)
+		fingerprint := hex.EncodeToString(sum[:])
+		storeKey := principal + "|" + c.Request.URL.Path + "|" + key
+
+		state, resp, done := store.Begin(storeKey, fingerprint)
+		if state == idempotency.StateInFlight {
+			timer := time.NewTimer(wait)
+			select {
+			case <-done:
+				timer.Stop()
+				state, resp, _ = store.Begin(storeKey, fingerprint)
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				timer.Stop()
+			}
+		}
+
+		switch state {
+		case idempotency.StateMismatch:
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+				"success": false,
+				"error":   "Idempotency-Key was already used with a different request body",
+			})
+			return
+		case idempotency.StateInFlight:
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+				"success": false,
+				"error":   "A request with this Idempotency-Key is still being processed",
+			})
+			return
+		case idempotency.StateReplay:
+			logger.WithContext(c.Request.Context()).Info("idempotent_replay",
+				zap.Int(logger.FieldStatusCode, resp.StatusCode),
+			)
+			for k, values := range resp.Header {
+				for _, v := range values {
+					c.Writer.Header().Add(k, v)
+				}
+			}
+			c.Writer.Header().Set(idempotentReplayedHeader, "true")
+			c.Writer.WriteHeader(resp.StatusCode)
+			_, _ = c.Writer.Write(resp.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &recordingWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = recorder
+
+		completed := false
+		defer func() {
+			if !completed {
+				store.Abort(storeKey)
+			}
+		}()
+
+		c.Next()
+
+		// Server errors are not remembered so that a retry gets a real second
+		// attempt.
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		header := http.Header{}
+		if ct := c.Writer.Header().Get("Content-Type"); ct != "" {
+			header.Set("Content-Type", ct)
+		}
+		store.Complete(storeKey, &idempotency.Response{
+			StatusCode: c.Writer.Status(),
+			Header:     header,
+			Body:       recorder.body.Bytes(),
+		})
+		completed = true
+	}
+}
+
+// idempotencyPrincipal identifies the caller authenticated by the auth
+// middlewares that ran before it: a company, the candidate of a test or an
+// anonymous session, in that order. It returns false when there is none.
+func idempotencyPrincipal(c *gin.Context) (string, bool) {
+	if id, ok := CompanyIDFromContext(c); ok {
+		return "company:" + strconv.Itoa(id), true
+	}
+	if claims, ok := CandidateFromContext(c); ok {
+		return "candidate:" + claims.TestID + ":" + claims.Email, true
+	}
+	if id, ok := SessionIDFromContext(c); ok {
+		return "session:" + id, true
+	}
+	return "", false
+}
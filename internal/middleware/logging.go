@@ -8,9 +8,17 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/redact"
 	"go.uber.org/zap"
 )
 
+const maxLoggedBodySize = 1000
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 type bodyLogWriter struct {
 	gin.ResponseWriter
 	body *bytes.Buffer
@@ -21,7 +29,9 @@ func (w bodyLogWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
-func LoggingMiddleware() gin.HandlerFunc {
+// LoggingMiddleware logs every request and its outcome. Small request bodies
+// are included after passing through the redactor.
+func LoggingMiddleware(redactor *redact.Redactor) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if c.Request.URL.Path == "/health" {
 			c.Next()
@@ -54,10 +64,15 @@ func LoggingMiddleware() gin.HandlerFunc {
 		ctx = logger.ToContext(ctx, log)
 		c.Request = c.Request.WithContext(ctx)
 
+		// Only a prefix of the body is read so that large uploads are never
+		// buffered here; the rest is stitched back behind it.
 		var requestBody []byte
 		if c.Request.Body != nil && shouldLogBody(c.Request.Method, c.Request.URL.Path) {
-			requestBody, _ = io.ReadAll(c.Request.Body)
-			c.Request.Body = io.NopCloser(bytes.NewBuffer(requestBody))
+			requestBody, _ = io.ReadAll(io.LimitReader(c.Request.Body, maxLoggedBodySize))
+			c.Request.Body = readCloser{
+				Reader: io.MultiReader(bytes.NewReader(requestBody), c.Request.Body),
+				Closer: c.Request.Body,
+			}
 		}
 
 		blw := &bodyLogWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
@@ -66,8 +81,8 @@ func LoggingMiddleware() gin.HandlerFunc {
 		fields := logger.NewFields().
 			With(zap.Int64(logger.FieldRequestSize, c.Request.ContentLength))
 
-		if len(requestBody) > 0 && len(requestBody) < 1000 { // Only log small bodies
-			fields = fields.With(zap.String("request_body", string(requestBody)))
+		if len(requestBody) > 0 && len(requestBody) < maxLoggedBodySize { // Only log small bodies
+			fields = fields.With(zap.String("request_body", string(redactor.JSON(requestBody))))
 		}
 
 		log.Info("request_started", fields...)
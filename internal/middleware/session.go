@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	SessionCookie = "session_id"
+	sessionIDKey  = "session_id"
+
+	sessionIDBytes   = 16
+	sessionMaxAgeSec = 30 * 24 * 60 * 60
+)
+
+// SessionMiddleware gives every caller an anonymous session, kept in an
+// HTTP-only cookie signed with secret, so that jobs can be tied to the
+// browser that submitted them. A missing, malformed or forged cookie is
+// replaced with a fresh session. With an empty secret a random one is used,
+// and sessions do not survive a restart.
+func SessionMiddleware(secret []byte) gin.HandlerFunc {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic("failed to generate session secret: " + err.Error())
+		}
+	}
+
+	return func(c *gin.Context) {
+		cookie, err := c.Cookie(SessionCookie)
+		id, ok := "", false
+		if err == nil {
+			id, ok = verifySession(secret, cookie)
+		}
+		if !ok {
+			id, err = newSessionID()
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"success": false,
+					"error":   "Failed to create session",
+				})
+				return
+			}
+			c.SetSameSite(http.SameSiteLaxMode)
+			c.SetCookie(SessionCookie, id+"."+signSession(secret, id), sessionMaxAgeSec, "/", "", c.Request.TLS != nil, true)
+		}
+
+		c.Set(sessionIDKey, id)
+		c.Next()
+	}
+}
+
+// SessionIDFromContext returns the session set by SessionMiddleware, if any.
+func SessionIDFromContext(c *gin.Context) (string, bool) {
+	v, ok := c.Get(sessionIDKey)
+	if !ok {
+		return "", false
+	}
+	id, ok := v.(string)
+	return id, ok
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, sessionIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func signSession(secret []byte, id string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifySession returns the session ID of a cookie value of the form
+// ID.SIGNATURE when the signature is valid.
+func verifySession(secret []byte, cookie string) (string, bool) {
+	id, sig, ok := strings.Cut(cookie, ".")
+	if !ok || len(id) != 2*sessionIDBytes {
+		return "", false
+	}
+	if !hmac.Equal([]byte(sig), []byte(signSession(secret, id))) {
+		return "", false
+	}
+	return id, true
+}
@@ -27,19 +27,52 @@ type Problem struct {
 
 // ProblemResponse is used for API responses with string timestamps
 type ProblemResponse struct {
-	ID          int    `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Difficulty  string `json:"difficulty"`
-	CreatedAt   string `json:"created_at,omitempty"`
-	UpdatedAt   string `json:"updated_at,omitempty"`
+	ID             int      `json:"id"`
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	Difficulty     string   `json:"difficulty"`
+	Tags           []string `json:"tags,omitempty"`
+	OwnerCompanyID int      `json:"owner_company_id,omitempty"`
+	TimeLimitMs    int      `json:"time_limit_ms,omitempty"`
+	MemoryLimitKB  int      `json:"memory_limit_kb,omitempty"`
+	Visibility     string   `json:"visibility,omitempty"`
+	Version        int      `json:"version,omitempty"`
+	CreatedAt      string   `json:"created_at,omitempty"`
+	UpdatedAt      string   `json:"updated_at,omitempty"`
+}
+
+// ProblemRequest is the request for creating or replacing a company-owned problem
+type ProblemRequest struct {
+	Title         string   `json:"title" binding:"required"`
+	Description   string   `json:"description" binding:"required"`
+	Difficulty    string   `json:"difficulty" binding:"required"`
+	Tags          []string `json:"tags"`
+	TimeLimitMs   int      `json:"time_limit_ms" binding:"omitempty,min=100,max=60000"`
+	MemoryLimitKB int      `json:"memory_limit_kb" binding:"omitempty,min=1024,max=1048576"`
+	Visibility    string   `json:"visibility" binding:"omitempty,oneof=private public"`
+}
+
+// SaveProblemResponse is the response for creating or updating a problem
+type SaveProblemResponse struct {
+	Success bool             `json:"success"`
+	Problem *ProblemResponse `json:"problem,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// DeleteProblemResponse is the response for deleting a problem
+type DeleteProblemResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
 // ListProblemsResponse is the response for listing problems
 type ListProblemsResponse struct {
-	Success  bool              `json:"success"`
-	Problems []ProblemResponse `json:"problems"`
-	Error    string            `json:"error,omitempty"`
+	Success       bool              `json:"success"`
+	Problems      []ProblemResponse `json:"problems"`
+	NextPageToken string            `json:"next_page_token,omitempty"`
+	TotalCount    int               `json:"total_count,omitempty"`
+	Error         string            `json:"error,omitempty"`
 }
 
 // GetProblemResponse is the response for getting a single problem
@@ -66,6 +99,28 @@ type TestResult struct {
 	ActualOutput   string `json:"actual_output"`
 	Error          string `json:"error,omitempty"`
 	Passed         bool   `json:"passed"`
+	Verdict        string `json:"verdict,omitempty"`
+	WallTimeMs     int64  `json:"wall_time_ms"`
+	CPUTimeMs      int64  `json:"cpu_time_ms"`
+	MemoryKB       int64  `json:"memory_kb"`
+	ExitCode       int    `json:"exit_code"`
+	Signal         string `json:"signal,omitempty"`
+	// OutputTruncated and ErrorTruncated mark ActualOutput and Error as cut
+	// to the executor's output limit.
+	OutputTruncated bool `json:"output_truncated,omitempty"`
+	ErrorTruncated  bool `json:"error_truncated,omitempty"`
+	// Hidden marks results of hidden test cases, whose Input and
+	// ExpectedOutput are left out.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// CompileDiagnostic is a compiler error or warning. Line and Column are
+// 1-based and zero when unknown.
+type CompileDiagnostic struct {
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
 }
 
 type ExecutionResults struct {
@@ -78,6 +133,50 @@ type ExecuteRequest struct {
 	Language  string `json:"language" binding:"required"`
 	Code      string `json:"code" binding:"required"`
 	ProblemID int    `json:"problem_id,omitempty"`
+	// Mode is program (the default) or harness, where Code is only the
+	// problem's function and requires ProblemID.
+	Mode string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+	// CallbackURL receives a signed job.finished webhook once the job is
+	// done. Only companies may set it.
+	CallbackURL string `json:"callback_url,omitempty" binding:"omitempty,url,max=2048"`
+}
+
+// RunRequest is the request for a scratch run: the code runs once on Stdin
+// and nothing is graded. With ExpectedOutput the result also reports whether
+// stdout matched it.
+type RunRequest struct {
+	Language       string  `json:"language" binding:"required"`
+	Code           string  `json:"code" binding:"required"`
+	Stdin          string  `json:"stdin"`
+	ExpectedOutput *string `json:"expected_output,omitempty"`
+	// ProblemID only selects the harness in harness mode.
+	ProblemID int    `json:"problem_id,omitempty"`
+	Mode      string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+}
+
+// RunResult is the outcome of a scratch run
+type RunResult struct {
+	Stdout          string `json:"stdout"`
+	Stderr          string `json:"stderr"`
+	Verdict         string `json:"verdict,omitempty"`
+	ExitCode        int    `json:"exit_code"`
+	Signal          string `json:"signal,omitempty"`
+	RuntimeMs       int64  `json:"runtime_ms"`
+	CPUTimeMs       int64  `json:"cpu_time_ms"`
+	MemoryKB        int64  `json:"memory_kb"`
+	Passed          *bool  `json:"passed,omitempty"`
+	StdoutTruncated bool   `json:"stdout_truncated,omitempty"`
+	StderrTruncated bool   `json:"stderr_truncated,omitempty"`
+}
+
+// ExecuteTestRequest is the request for running code within a coding test
+type ExecuteTestRequest struct {
+	Language string `json:"language" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	Mode     string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+	// ProblemID selects the problem of a multi-problem assessment; the
+	// first problem is used when it is left out
+	ProblemID int `json:"problem_id,omitempty"`
 }
 
 // ExecuteResponse is the response for executing code
@@ -96,6 +195,213 @@ type JobStatusResponse struct {
 	Output      string       `json:"output,omitempty"`
 	Error       string       `json:"error,omitempty"`
 	TestResults []TestResult `json:"test_results,omitempty"`
+	Run         *RunResult   `json:"run,omitempty"`
+	// Verdict is the first failing verdict across the test cases, or AC
+	// once every case passed.
+	Verdict            string              `json:"verdict,omitempty"`
+	CompileDiagnostics []CompileDiagnostic `json:"compile_diagnostics,omitempty"`
+	OutputTruncated    bool                `json:"output_truncated,omitempty"`
+}
+
+// BatchExecuteItem is one submission in a batch execution
+type BatchExecuteItem struct {
+	Language       string `json:"language" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+	ProblemID      int    `json:"problem_id" binding:"required,min=1"`
+	ProblemVersion int    `json:"problem_version,omitempty" binding:"omitempty,min=1"`
+	Mode           string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+}
+
+// BatchExecuteRequest is the request for running many submissions at once
+type BatchExecuteRequest struct {
+	Items []BatchExecuteItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// BatchItemStatus is the state of one item of a batch. Index is the item's
+// position in the request. Items that could not be submitted have no JobID
+// and status submit_failed.
+type BatchItemStatus struct {
+	Index       int    `json:"index"`
+	JobID       string `json:"job_id,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Verdict     string `json:"verdict,omitempty"`
+	PassedCount int    `json:"passed_count,omitempty"`
+	TotalCount  int    `json:"total_count,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// BatchExecuteResponse is the response for submitting a batch
+type BatchExecuteResponse struct {
+	Success   bool              `json:"success"`
+	BatchID   string            `json:"batch_id,omitempty"`
+	Submitted int               `json:"submitted"`
+	Failed    int               `json:"failed"`
+	Items     []BatchItemStatus `json:"items,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// BatchStatusResponse aggregates the jobs of a batch. Counts is keyed by
+// item status.
+type BatchStatusResponse struct {
+	Success bool              `json:"success"`
+	BatchID string            `json:"batch_id,omitempty"`
+	Status  string            `json:"status,omitempty"`
+	Counts  map[string]int    `json:"counts,omitempty"`
+	Items   []BatchItemStatus `json:"items,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// WebhookAttempt is one HTTP request of a webhook delivery. StatusCode is
+// zero when no response was received.
+type WebhookAttempt struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// WebhookDelivery is a webhook event sent to one URL
+type WebhookDelivery struct {
+	ID           string           `json:"id"`
+	Event        string           `json:"event"`
+	WebhookID    int              `json:"webhook_id,omitempty"`
+	JobID        string           `json:"job_id,omitempty"`
+	TestID       string           `json:"test_id,omitempty"`
+	RedeliveryOf string           `json:"redelivery_of,omitempty"`
+	URL          string           `json:"url"`
+	Status       string           `json:"status"`
+	CreatedAt    time.Time        `json:"created_at"`
+	Attempts     []WebhookAttempt `json:"attempts"`
+}
+
+// ListWebhookDeliveriesResponse is the response for listing webhook
+// deliveries, newest first
+type ListWebhookDeliveriesResponse struct {
+	Success    bool              `json:"success"`
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// WebhookDeliveryResponse is the response for getting a webhook delivery
+type WebhookDeliveryResponse struct {
+	Success  bool             `json:"success"`
+	Delivery *WebhookDelivery `json:"delivery,omitempty"`
+	Error    string           `json:"error,omitempty"`
+}
+
+// CreateWebhookRequest subscribes a URL to coding test lifecycle events
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required,url,max=2048"`
+	Events []string `json:"events" binding:"required,min=1,dive,oneof=test.started test.submitted test.expired test.graded"`
+}
+
+// WebhookSubscription is a company's subscription to coding test events.
+// Secret is only returned when the subscription is created.
+type WebhookSubscription struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookSubscriptionResponse is the response for creating a webhook
+// subscription
+type WebhookSubscriptionResponse struct {
+	Success bool                 `json:"success"`
+	Webhook *WebhookSubscription `json:"webhook,omitempty"`
+	Error   string               `json:"error,omitempty"`
+}
+
+// CallbackSecretResponse is the response for getting the secret a company's
+// job callbacks are signed with
+type CallbackSecretResponse struct {
+	Success bool   `json:"success"`
+	Secret  string `json:"secret,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ListWebhookSubscriptionsResponse is the response for listing a company's
+// webhook subscriptions
+type ListWebhookSubscriptionsResponse struct {
+	Success  bool                  `json:"success"`
+	Webhooks []WebhookSubscription `json:"webhooks"`
+	Error    string                `json:"error,omitempty"`
+}
+
+// AssessmentTemplate is a named set of settings a company generates tests
+// from
+type AssessmentTemplate struct {
+	ID                  int                 `json:"id"`
+	CompanyID           int                 `json:"company_id"`
+	Name                string              `json:"name"`
+	Problems            []AssessmentProblem `json:"problems"`
+	TestDurationMinutes int                 `json:"test_duration_minutes"`
+	ExpiresInHours      int                 `json:"expires_in_hours"`
+	AllowedLanguages    []string            `json:"allowed_languages,omitempty"`
+	Instructions        string              `json:"instructions,omitempty"`
+	PassingThreshold    int                 `json:"passing_threshold,omitempty"`
+	CreatedAt           time.Time           `json:"created_at"`
+	UpdatedAt           time.Time           `json:"updated_at"`
+}
+
+// AssessmentTemplateRequest is the request for creating or replacing an
+// assessment template. An empty allowed_languages allows every language and
+// a zero passing_threshold disables pass/fail
+type AssessmentTemplateRequest struct {
+	Name                string              `json:"name" binding:"required,max=100"`
+	Problems            []AssessmentProblem `json:"problems" binding:"required,min=1,max=20,dive"`
+	TestDurationMinutes int                 `json:"test_duration_minutes" binding:"required,min=1,max=1440"`
+	ExpiresInHours      int                 `json:"expires_in_hours" binding:"required,min=1"`
+	AllowedLanguages    []string            `json:"allowed_languages" binding:"omitempty,max=20,dive,required,max=32"`
+	Instructions        string              `json:"instructions" binding:"max=10000"`
+	PassingThreshold    int                 `json:"passing_threshold" binding:"min=0,max=100"`
+}
+
+// AssessmentTemplateResponse is the response for a single assessment template
+type AssessmentTemplateResponse struct {
+	Success  bool                `json:"success"`
+	Template *AssessmentTemplate `json:"template,omitempty"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// ListAssessmentTemplatesResponse is the response for listing a company's
+// assessment templates
+type ListAssessmentTemplatesResponse struct {
+	Success   bool                 `json:"success"`
+	Templates []AssessmentTemplate `json:"templates"`
+	Error     string               `json:"error,omitempty"`
+}
+
+// DeleteAssessmentTemplateResponse is the response for deleting an
+// assessment template
+type DeleteAssessmentTemplateResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DeleteWebhookResponse is the response for deleting a webhook subscription
+type DeleteWebhookResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TestEventPayload is the data of a coding test lifecycle webhook
+type TestEventPayload struct {
+	EventID    string     `json:"event_id"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	Test       CodingTest `json:"test"`
+}
+
+// CancelJobResponse is the response for cancelling a job
+type CancelJobResponse struct {
+	Success bool   `json:"success"`
+	JobID   string `json:"job_id"`
+	Status  string `json:"status,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
 type Company struct {
@@ -124,11 +430,6 @@ type LoginResponse struct {
 	Token   string   `json:"token,omitempty"`
 }
 
-// GenerateAPIKeyRequest is the request for generating an API key
-type GenerateAPIKeyRequest struct {
-	CompanyID int `json:"company_id" binding:"required"`
-}
-
 // GenerateAPIKeyResponse is the response for generating an API key
 type GenerateAPIKeyResponse struct {
 	Success bool   `json:"success"`
@@ -136,11 +437,6 @@ type GenerateAPIKeyResponse struct {
 	APIKey  string `json:"api_key,omitempty"`
 }
 
-// GenerateClientIDRequest is the request for generating a client ID
-type GenerateClientIDRequest struct {
-	CompanyID int `json:"company_id" binding:"required"`
-}
-
 // GenerateClientIDResponse is the response for generating a client ID
 type GenerateClientIDResponse struct {
 	Success  bool   `json:"success"`
@@ -152,6 +448,7 @@ type CodingTest struct {
 	ID                  string     `json:"id" db:"id"`
 	CompanyID           int        `json:"company_id" db:"company_id"`
 	ProblemID           int        `json:"problem_id" db:"problem_id"`
+	ProblemVersion      int        `json:"problem_version,omitempty" db:"problem_version"`
 	CandidateName       *string    `json:"candidate_name" db:"candidate_name"`
 	CandidateEmail      *string    `json:"candidate_email" db:"candidate_email"`
 	Status              string     `json:"status" db:"status"`
@@ -163,6 +460,33 @@ type CodingTest struct {
 	PassedPercentage    *int       `json:"passed_percentage" db:"passed_percentage"`
 	CreatedAt           time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at" db:"updated_at"`
+	// Problems and Submissions are only set for multi-problem assessments
+	Problems    []AssessmentProblem `json:"problems,omitempty" db:"-"`
+	Submissions []ProblemSubmission `json:"submissions,omitempty" db:"-"`
+	// Settings copied from the template the test was generated from, if any.
+	// Passed is set once a test with a passing threshold is completed
+	TemplateID       int      `json:"template_id,omitempty" db:"-"`
+	AllowedLanguages []string `json:"allowed_languages,omitempty" db:"-"`
+	Instructions     string   `json:"instructions,omitempty" db:"-"`
+	PassingThreshold int      `json:"passing_threshold,omitempty" db:"-"`
+	Passed           *bool    `json:"passed,omitempty" db:"-"`
+}
+
+// AssessmentProblem is one problem of a multi-problem assessment. Weight is
+// its share of the total score and defaults to 1
+type AssessmentProblem struct {
+	ProblemID      int `json:"problem_id" binding:"required,min=1"`
+	ProblemVersion int `json:"problem_version,omitempty" binding:"omitempty,min=1"`
+	Weight         int `json:"weight,omitempty" binding:"omitempty,min=1,max=100"`
+}
+
+// ProblemSubmission is the latest submission for a problem of an assessment
+type ProblemSubmission struct {
+	ProblemID        int       `json:"problem_id"`
+	Language         string    `json:"language"`
+	Code             string    `json:"code,omitempty"`
+	PassedPercentage int       `json:"passed_percentage"`
+	SubmittedAt      time.Time `json:"submitted_at"`
 }
 
 // TestCaseResponse is used for API responses with string timestamps
@@ -172,10 +496,173 @@ type TestCaseResponse struct {
 	Input          string `json:"input"`
 	ExpectedOutput string `json:"expected_output"`
 	IsHidden       bool   `json:"is_hidden"`
+	Weight         int    `json:"weight"`
+	Position       int    `json:"position"`
 	CreatedAt      string `json:"created_at,omitempty"`
 	UpdatedAt      string `json:"updated_at,omitempty"`
 }
 
+// ReferenceSolution is run against new or changed test cases before they are
+// saved; every case must pass.
+type ReferenceSolution struct {
+	Language string `json:"language" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// TestCaseRequest is a single test case in a create, update or bulk request.
+// Weight defaults to 1 when it is left out; a case of weight 0 is not scored
+type TestCaseRequest struct {
+	Input          string `json:"input"`
+	ExpectedOutput string `json:"expected_output"`
+	IsHidden       bool   `json:"is_hidden"`
+	Weight         *int   `json:"weight" binding:"omitempty,min=0,max=1000"`
+}
+
+// SaveTestCaseRequest is the request for creating or replacing a test case
+type SaveTestCaseRequest struct {
+	TestCaseRequest
+	ReferenceSolution *ReferenceSolution `json:"reference_solution" binding:"required"`
+}
+
+// PatchTestCaseRequest changes test case settings that do not affect its
+// expected output
+type PatchTestCaseRequest struct {
+	IsHidden *bool `json:"is_hidden"`
+	Weight   *int  `json:"weight" binding:"omitempty,min=0,max=1000"`
+}
+
+// BulkTestCasesRequest is the JSON form of a bulk test case upload
+type BulkTestCasesRequest struct {
+	TestCases         []TestCaseRequest  `json:"test_cases" binding:"required,min=1,dive"`
+	ReferenceSolution *ReferenceSolution `json:"reference_solution" binding:"required"`
+	ReplaceExisting   bool               `json:"replace_existing"`
+}
+
+// ReorderTestCasesRequest lists every test case of a problem in its new order
+type ReorderTestCasesRequest struct {
+	TestCaseIDs []int `json:"test_case_ids" binding:"required,min=1"`
+}
+
+// FileError reports a problem with one file of an uploaded archive
+type FileError struct {
+	File    string `json:"file"`
+	Message string `json:"message"`
+}
+
+// SaveTestCaseResponse is the response for creating or updating a test case.
+// Failures lists the cases the reference solution did not reproduce.
+type SaveTestCaseResponse struct {
+	Success  bool              `json:"success"`
+	TestCase *TestCaseResponse `json:"test_case,omitempty"`
+	Failures []TestResult      `json:"failures,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// SaveTestCasesResponse is the response for bulk uploads and reordering
+type SaveTestCasesResponse struct {
+	Success    bool               `json:"success"`
+	TestCases  []TestCaseResponse `json:"test_cases,omitempty"`
+	Failures   []TestResult       `json:"failures,omitempty"`
+	FileErrors []FileError        `json:"file_errors,omitempty"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// ImportProblemResponse is the response for importing a problem package.
+// FileErrors lists every problem found in the package, per file.
+type ImportProblemResponse struct {
+	Success    bool               `json:"success"`
+	Problem    *ProblemResponse   `json:"problem,omitempty"`
+	TestCases  []TestCaseResponse `json:"test_cases,omitempty"`
+	FileErrors []FileError        `json:"file_errors,omitempty"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// ProblemVersionInfo summarizes one version of a problem
+type ProblemVersionInfo struct {
+	Version       int    `json:"version"`
+	Title         string `json:"title"`
+	TestCaseCount int    `json:"test_case_count"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
+
+// ListProblemVersionsResponse is the response for listing a problem's versions
+type ListProblemVersionsResponse struct {
+	Success  bool                 `json:"success"`
+	Versions []ProblemVersionInfo `json:"versions"`
+	Error    string               `json:"error,omitempty"`
+}
+
+// ProblemVersionResponse is a snapshot of a problem and its test cases
+type ProblemVersionResponse struct {
+	Success   bool               `json:"success"`
+	Version   int                `json:"version,omitempty"`
+	Problem   *ProblemResponse   `json:"problem,omitempty"`
+	TestCases []TestCaseResponse `json:"test_cases,omitempty"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// DiffLine is one line of a line diff. Op is " " for unchanged lines, "-"
+// for removed and "+" for added ones.
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// FieldChange is a field whose value differs between two versions
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// TestCaseChange lists how a test case present in both versions changed
+type TestCaseChange struct {
+	ID      int           `json:"id"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// ProblemVersionDiffResponse describes what changed between two versions of
+// a problem
+type ProblemVersionDiffResponse struct {
+	Success          bool               `json:"success"`
+	From             int                `json:"from,omitempty"`
+	To               int                `json:"to,omitempty"`
+	Fields           []FieldChange      `json:"fields,omitempty"`
+	Description      []DiffLine         `json:"description,omitempty"`
+	AddedTestCases   []TestCaseResponse `json:"added_test_cases,omitempty"`
+	RemovedTestCases []TestCaseResponse `json:"removed_test_cases,omitempty"`
+	ChangedTestCases []TestCaseChange   `json:"changed_test_cases,omitempty"`
+	Error            string             `json:"error,omitempty"`
+}
+
+// TestProblemResponse is the problem a candidate works on, at the version
+// pinned by their test. Hidden test cases are left out.
+type TestProblemResponse struct {
+	Success   bool               `json:"success"`
+	Problem   *ProblemResponse   `json:"problem,omitempty"`
+	TestCases []TestCaseResponse `json:"test_cases,omitempty"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// StarterCodeResponse is the code a candidate starts from in one language.
+// In harness mode Code is a stub of FunctionName and should be submitted with
+// mode harness.
+type StarterCodeResponse struct {
+	Success      bool   `json:"success"`
+	Language     string `json:"language,omitempty"`
+	Mode         string `json:"mode,omitempty"`
+	FunctionName string `json:"function_name,omitempty"`
+	Code         string `json:"code,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// DeleteTestCaseResponse is the response for deleting a test case
+type DeleteTestCaseResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
 // GetTestCasesByProblemIDResponse is the response for getting test cases for a problem
 type GetTestCasesByProblemIDResponse struct {
 	Success   bool               `json:"success"`
@@ -196,32 +683,121 @@ type StartTestRequest struct {
 	CandidateEmail string `json:"candidate_email" binding:"required"`
 }
 
-// StartTestResponse is the response for starting a test
+// StartTestResponse is the response for starting a test. Token must be sent
+// in the X-Candidate-Token header of the candidate's later requests.
 type StartTestResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Success        bool       `json:"success"`
+	Message        string     `json:"message,omitempty"`
+	Token          string     `json:"token,omitempty"`
+	TokenExpiresAt *time.Time `json:"token_expires_at,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
+
+// SaveDraftRequest is the request for autosaving a candidate's code
+type SaveDraftRequest struct {
+	Language  string `json:"language" binding:"required"`
+	Code      string `json:"code"`
+	Mode      string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+	ProblemID int    `json:"problem_id,omitempty"`
+}
+
+// Draft is a saved version of a candidate's code
+type Draft struct {
+	Version   int       `json:"version"`
+	ProblemID int       `json:"problem_id,omitempty"`
+	Language  string    `json:"language"`
+	Mode      string    `json:"mode,omitempty"`
+	Code      string    `json:"code"`
+	SavedAt   time.Time `json:"saved_at"`
+}
+
+// DraftVersion identifies a saved draft without its code
+type DraftVersion struct {
+	Version int       `json:"version"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// DraftResponse is the response for saving or getting a draft. Versions is
+// only set when getting one, oldest first.
+type DraftResponse struct {
+	Success  bool           `json:"success"`
+	Draft    *Draft         `json:"draft,omitempty"`
+	Versions []DraftVersion `json:"versions,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// TimeRemainingResponse reports the time left in a coding test. Deadline is
+// when the test is submitted automatically.
+type TimeRemainingResponse struct {
+	Success          bool       `json:"success"`
+	Status           string     `json:"status,omitempty"`
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	Deadline         *time.Time `json:"deadline,omitempty"`
+	RemainingSeconds int        `json:"remaining_seconds"`
+	ServerTime       time.Time  `json:"server_time"`
+	Error            string     `json:"error,omitempty"`
+}
+
+// CandidateTokenResponse is the response for refreshing a candidate token
+type CandidateTokenResponse struct {
+	Success   bool       `json:"success"`
+	Token     string     `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Error     string     `json:"error,omitempty"`
 }
 
-// SubmitTestRequest is the request for submitting a test
+// SubmitTestRequest is the request for submitting a test. The gateway grades
+// the code against the test's problem; language defaults to go. Multi-problem
+// assessments are submitted without code: their problems are submitted one
+// by one and the gateway computes the weighted total
 type SubmitTestRequest struct {
-	Code             string `json:"code" binding:"required"`
-	PassedPercentage int    `json:"passed_percentage" binding:"required"`
+	Code     string `json:"code"`
+	Language string `json:"language,omitempty"`
+	Mode     string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
 }
 
-// SubmitTestResponse is the response for submitting a test
+// SubmitTestResponse is the response for submitting a test. TotalScore is
+// the graded score, the weighted total for multi-problem assessments
 type SubmitTestResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Success    bool   `json:"success"`
+	Message    string `json:"message,omitempty"`
+	TotalScore *int   `json:"total_score,omitempty"`
+	// Passed is set for tests with a passing threshold
+	Passed *bool  `json:"passed,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SubmitProblemRequest is the request for submitting one problem of an
+// assessment
+type SubmitProblemRequest struct {
+	Language string `json:"language" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	Mode     string `json:"mode,omitempty" binding:"omitempty,oneof=program harness"`
+}
+
+// SubmitProblemResponse is the response for submitting one problem of an
+// assessment. TotalScore is the weighted score of all submissions so far
+type SubmitProblemResponse struct {
+	Success    bool               `json:"success"`
+	Submission *ProblemSubmission `json:"submission,omitempty"`
+	TotalScore int                `json:"total_score"`
+	Error      string             `json:"error,omitempty"`
 }
 
 // GenerateTestRequest is the request for generating a test
 type GenerateTestRequest struct {
-	CompanyID      int     `json:"company_id" binding:"required"`
 	ClientID       *string `json:"client_id" binding:"required"`
-	ProblemID      int     `json:"problem_id" binding:"required"`
-	ExpiresInHours int     `json:"expires_in_hours" binding:"required"`
+	ProblemID      int     `json:"problem_id" binding:"required_without_all=Problems TemplateID"`
+	ProblemVersion int     `json:"problem_version" binding:"omitempty,min=1"`
+	// ExpiresInHours defaults to the template's when template_id is set
+	ExpiresInHours int `json:"expires_in_hours" binding:"required_without=TemplateID"`
+	// Problems makes the test a multi-problem assessment; problem_id and
+	// problem_version must then be left out
+	Problems            []AssessmentProblem `json:"problems" binding:"omitempty,min=1,max=20,dive"`
+	TestDurationMinutes int                 `json:"test_duration_minutes" binding:"omitempty,min=1,max=1440"`
+	// TemplateID takes the test's problems and settings from a template
+	// instead of the fields above
+	TemplateID int `json:"template_id" binding:"omitempty,min=1"`
 }
 
 // GenerateTestResponse is the response for generating a test
@@ -239,9 +815,84 @@ type GetCompanyTestsResponse struct {
 	Error   string       `json:"error,omitempty"`
 }
 
+// LimitViolation describes which request limit was exceeded
+type LimitViolation struct {
+	Limit   string `json:"limit"`
+	Max     int    `json:"max,omitempty"`
+	Actual  int    `json:"actual,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// LimitErrorResponse is returned when a request is rejected by a size or input limit
+type LimitErrorResponse struct {
+	Success   bool            `json:"success"`
+	Error     string          `json:"error"`
+	Violation *LimitViolation `json:"violation,omitempty"`
+}
+
+// PurgeCacheResponse is the response for purging gateway cache entries
+type PurgeCacheResponse struct {
+	Success bool   `json:"success"`
+	Purged  int    `json:"purged"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AuditEvent is a single entry of a company's audit trail
+type AuditEvent struct {
+	ID            string            `json:"id"`
+	Time          time.Time         `json:"time"`
+	CompanyID     int               `json:"company_id,omitempty"`
+	Actor         string            `json:"actor,omitempty"`
+	Action        string            `json:"action"`
+	Outcome       string            `json:"outcome"`
+	ClientIP      string            `json:"client_ip,omitempty"`
+	RequestID     string            `json:"request_id,omitempty"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	Details       map[string]string `json:"details,omitempty"`
+}
+
+// ListAuditEventsResponse is the response for listing a company's audit events
+type ListAuditEventsResponse struct {
+	Success bool         `json:"success"`
+	Events  []AuditEvent `json:"events"`
+	Error   string       `json:"error,omitempty"`
+}
+
 const (
 	TestStatusPending   = "pending"
 	TestStatusStarted   = "started"
 	TestStatusCompleted = "completed"
 	TestStatusExpired   = "expired"
 )
+
+// Batch statuses. A batch is running until every job is terminal; it then
+// has partial failures if any item failed to submit, failed or was
+// cancelled.
+const (
+	BatchStatusRunning        = "running"
+	BatchStatusCompleted      = "completed"
+	BatchStatusPartialFailure = "partial_failure"
+
+	BatchItemSubmitFailed = "submit_failed"
+)
+
+// Verdicts of a test case, scratch run or whole job
+const (
+	VerdictAccepted            = "AC"
+	VerdictWrongAnswer         = "WA"
+	VerdictTimeLimitExceeded   = "TLE"
+	VerdictMemoryLimitExceeded = "MLE"
+	VerdictRuntimeError        = "RE"
+	VerdictCompileError        = "CE"
+)
+
+const (
+	ExecutionModeProgram = "program"
+	ExecutionModeHarness = "harness"
+)
+
+const (
+	ProblemVisibilityPrivate = "private"
+	ProblemVisibilityPublic  = "public"
+)
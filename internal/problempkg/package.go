@@ -0,0 +1,135 @@
+// Package problempkg reads and writes portable problem packages, so problems
+// can be kept in git and synced with the problems service. A package is a
+// directory or zip archive laid out as
+//
+//	problem.yaml     metadata and per-test settings
+//	statement.md     problem statement in Markdown
+//	tests/NAME.in    test input
+//	tests/NAME.out   expected output for NAME.in
+//	solutions/*.EXT  reference solutions; the language comes from EXT
+//	starter/*.EXT    starter code, at most one file per language
+//	harness/*.EXT    optional I/O glue that lets candidates implement just a
+//	                 function; listed under harnesses in problem.yaml
+//
+// Test cases run in name order, numerically when names are numbers.
+package problempkg
+
+import (
+	"path"
+	"sort"
+)
+
+const (
+	ManifestFile  = "problem.yaml"
+	StatementFile = "statement.md"
+
+	// HarnessPlaceholder marks the line of a harness template that the
+	// candidate's code replaces.
+	HarnessPlaceholder = "{{solution}}"
+
+	testsDir     = "tests"
+	solutionsDir = "solutions"
+	starterDir   = "starter"
+	harnessDir   = "harness"
+)
+
+// Manifest is the content of problem.yaml.
+type Manifest struct {
+	Title         string   `yaml:"title"`
+	Difficulty    string   `yaml:"difficulty"`
+	Tags          []string `yaml:"tags,omitempty"`
+	TimeLimitMs   int      `yaml:"time_limit_ms,omitempty"`
+	MemoryLimitKB int      `yaml:"memory_limit_kb,omitempty"`
+	Visibility    string   `yaml:"visibility,omitempty"`
+	// TestCases holds per-case settings keyed by test file name without
+	// extension. Cases that are not listed are visible with weight 1.
+	TestCases []TestCaseSettings `yaml:"test_cases,omitempty"`
+	// Harnesses names the function candidates implement, per language with
+	// a file in harness/.
+	Harnesses []HarnessSettings `yaml:"harnesses,omitempty"`
+}
+
+type HarnessSettings struct {
+	Language     string `yaml:"language"`
+	FunctionName string `yaml:"function_name"`
+}
+
+type TestCaseSettings struct {
+	Name   string `yaml:"name"`
+	Hidden bool   `yaml:"hidden,omitempty"`
+	// Weight defaults to 1 when it is left out; an explicit 0 is kept.
+	Weight *int `yaml:"weight,omitempty"`
+}
+
+// Package is a problem together with everything needed to recreate it.
+type Package struct {
+	Manifest           Manifest
+	Statement          string
+	TestCases          []TestCase
+	ReferenceSolutions []SourceFile
+	StarterCode        []SourceFile
+	Harnesses          []Harness
+}
+
+type TestCase struct {
+	Name           string
+	Input          string
+	ExpectedOutput string
+	IsHidden       bool
+	Weight         int
+}
+
+// SourceFile is a reference solution or starter code file. Path is relative
+// to the package root.
+type SourceFile struct {
+	Path     string
+	Language string
+	Code     string
+}
+
+// Harness is the I/O glue for candidates who implement FunctionName rather
+// than a whole program. Template contains HarnessPlaceholder.
+type Harness struct {
+	Path         string
+	Language     string
+	FunctionName string
+	Template     string
+}
+
+var languageByExt = map[string]string{
+	".c":    "c",
+	".cpp":  "cpp",
+	".go":   "go",
+	".java": "java",
+	".js":   "javascript",
+	".py":   "python",
+	".rs":   "rust",
+	".ts":   "typescript",
+}
+
+// LanguageForFile returns the language of a source file from its extension.
+func LanguageForFile(name string) (string, bool) {
+	lang, ok := languageByExt[path.Ext(name)]
+	return lang, ok
+}
+
+// extForLanguage returns the file extension used when exporting code in
+// language, or false for a language packages cannot hold.
+func extForLanguage(language string) (string, bool) {
+	for ext, lang := range languageByExt {
+		if lang == language {
+			return ext, true
+		}
+	}
+	return "", false
+}
+
+// SupportedExtensions lists the source file extensions a package may use.
+func SupportedExtensions() []string {
+	exts := make([]string, 0, len(languageByExt))
+	for ext := range languageByExt {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+	return exts
+}
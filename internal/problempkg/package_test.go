@@ -0,0 +1,404 @@
+package problempkg
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+const maxBytes = 1 << 20
+
+func weight(w int) *int {
+	return &w
+}
+
+// testPackage is a package as Export builds it, with two hidden or weighted
+// cases in the manifest.
+func testPackage() *Package {
+	return &Package{
+		Manifest: Manifest{
+			Title:         "Sum",
+			Difficulty:    "easy",
+			Tags:          []string{"math"},
+			TimeLimitMs:   2000,
+			MemoryLimitKB: 65536,
+			Visibility:    model.ProblemVisibilityPublic,
+			TestCases: []TestCaseSettings{
+				{Name: "002", Hidden: true, Weight: weight(1)},
+				{Name: "003", Weight: weight(0)},
+			},
+			Harnesses: []HarnessSettings{{Language: "python", FunctionName: "add"}},
+		},
+		Statement: "Add two numbers.\n",
+		TestCases: []TestCase{
+			{Name: "001", Input: "1 2\n", ExpectedOutput: "3\n", Weight: 1},
+			{Name: "002", Input: "2 2\n", ExpectedOutput: "4\n", IsHidden: true, Weight: 1},
+			{Name: "003", Input: "0 0\n", ExpectedOutput: "0\n", Weight: 0},
+		},
+		ReferenceSolutions: []SourceFile{
+			{Path: "solutions/fast.go", Language: "go", Code: "package main\n"},
+			{Path: "solutions/solution2.py", Language: "python", Code: "print(3)\n"},
+		},
+		StarterCode: []SourceFile{
+			{Path: "starter/python.py", Language: "python", Code: "def add(a, b):\n    pass\n"},
+		},
+		Harnesses: []Harness{
+			{Path: "harness/python.py", Language: "python", FunctionName: "add", Template: "{{solution}}\nprint(add(1, 2))\n"},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(t *testing.T, pkg *Package) (*Package, []model.FileError)
+	}{
+		{
+			name: "zip",
+			write: func(t *testing.T, pkg *Package) (*Package, []model.FileError) {
+				var buf bytes.Buffer
+				if err := pkg.WriteZip(&buf); err != nil {
+					t.Fatalf("WriteZip() error = %v", err)
+				}
+				return ReadZip(buf.Bytes(), maxBytes)
+			},
+		},
+		{
+			name: "directory",
+			write: func(t *testing.T, pkg *Package) (*Package, []model.FileError) {
+				dir := t.TempDir()
+				if err := pkg.WriteDir(dir); err != nil {
+					t.Fatalf("WriteDir() error = %v", err)
+				}
+				return Read(os.DirFS(dir), maxBytes)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := testPackage()
+			got, errs := tt.write(t, testPackage())
+			if len(errs) > 0 {
+				t.Fatalf("read back with errors %v", errs)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("read back\n%+v\nwant\n%+v", got, want)
+			}
+		})
+	}
+}
+
+func TestWriteDirReplacesFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := testPackage().WriteDir(dir); err != nil {
+		t.Fatalf("WriteDir() error = %v", err)
+	}
+	if err := os.WriteFile(dir+"/README.md", []byte("kept"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg := testPackage()
+	pkg.TestCases = pkg.TestCases[:1]
+	pkg.Manifest.TestCases = nil
+	if err := pkg.WriteDir(dir); err != nil {
+		t.Fatalf("WriteDir() error = %v", err)
+	}
+
+	if _, err := os.Stat(dir + "/tests/002.in"); !os.IsNotExist(err) {
+		t.Errorf("removed test case is still on disk: %v", err)
+	}
+	if _, err := os.Stat(dir + "/README.md"); err != nil {
+		t.Errorf("other file was removed: %v", err)
+	}
+}
+
+func TestExportNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		solution SourceFile
+		want     string
+		wantErr  []model.FileError
+	}{
+		{
+			name:     "stored path",
+			solution: SourceFile{Path: "solutions/fast.go", Language: "go"},
+			want:     "solutions/fast.go",
+		},
+		{
+			name:     "no stored path",
+			solution: SourceFile{Language: "go"},
+			want:     "solutions/solution1.go",
+		},
+		{
+			name:     "path outside the directory",
+			solution: SourceFile{Path: "../fast.go", Language: "go"},
+			want:     "solutions/solution1.go",
+		},
+		{
+			name:     "path that reads back as another language",
+			solution: SourceFile{Path: "solutions/fast.txt", Language: "go"},
+			want:     "solutions/solution1.go",
+		},
+		{
+			name:     "language without an extension",
+			solution: SourceFile{Language: "cobol"},
+			wantErr:  []model.FileError{{File: "solutions/solution1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := testPackage()
+			pkg.ReferenceSolutions = []SourceFile{tt.solution}
+
+			files, err := pkg.files()
+			var exportErr *ExportError
+			if tt.wantErr != nil {
+				if !errors.As(err, &exportErr) {
+					t.Fatalf("files() error = %v, want an ExportError", err)
+				}
+				if len(exportErr.Files) != len(tt.wantErr) || exportErr.Files[0].File != tt.wantErr[0].File {
+					t.Errorf("ExportError files = %v, want %v", exportErr.Files, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("files() error = %v", err)
+			}
+
+			var names []string
+			for _, f := range files {
+				if strings.HasPrefix(f.name, solutionsDir+"/") {
+					names = append(names, f.name)
+				}
+			}
+			if len(names) != 1 || names[0] != tt.want {
+				t.Errorf("solution written as %v, want %s", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	valid := func() fstest.MapFS {
+		return fstest.MapFS{
+			"problem.yaml":     {Data: []byte("title: Sum\ndifficulty: easy\n")},
+			"statement.md":     {Data: []byte("Add two numbers.")},
+			"tests/1.in":       {Data: []byte("1 2\n")},
+			"tests/1.out":      {Data: []byte("3\n")},
+			"solutions/a.go":   {Data: []byte("package main\n")},
+			"starter/go.go":    {Data: []byte("package main\n")},
+			"tests/.DS_Store":  {Data: []byte("ignored")},
+			"__MACOSX/foo.txt": {Data: []byte("ignored")},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(fsys fstest.MapFS)
+		// want lists the files with errors, or is empty for a valid package.
+		want []string
+	}{
+		{name: "valid", change: func(fstest.MapFS) {}},
+		{
+			name: "in a top-level directory",
+			change: func(fsys fstest.MapFS) {
+				for name, f := range fsys {
+					delete(fsys, name)
+					fsys["sum/"+name] = f
+				}
+			},
+		},
+		{
+			name:   "missing manifest",
+			change: func(fsys fstest.MapFS) { delete(fsys, "problem.yaml") },
+			want:   []string{"problem.yaml"},
+		},
+		{
+			name: "unknown manifest field",
+			change: func(fsys fstest.MapFS) {
+				fsys["problem.yaml"] = &fstest.MapFile{Data: []byte("title: Sum\ndifficulty: easy\nlevel: 3\n")}
+			},
+			want: []string{"problem.yaml"},
+		},
+		{
+			name: "invalid manifest values",
+			change: func(fsys fstest.MapFS) {
+				fsys["problem.yaml"] = &fstest.MapFile{Data: []byte("title: ' '\nvisibility: team\ntime_limit_ms: 5\n")}
+			},
+			want: []string{"problem.yaml", "problem.yaml", "problem.yaml", "problem.yaml"},
+		},
+		{
+			name:   "empty statement",
+			change: func(fsys fstest.MapFS) { fsys["statement.md"] = &fstest.MapFile{Data: []byte(" \n")} },
+			want:   []string{"statement.md"},
+		},
+		{
+			name:   "output without input",
+			change: func(fsys fstest.MapFS) { fsys["tests/2.out"] = &fstest.MapFile{Data: []byte("4\n")} },
+			want:   []string{"tests/2.out"},
+		},
+		{
+			name: "no test cases",
+			change: func(fsys fstest.MapFS) {
+				delete(fsys, "tests/1.in")
+				delete(fsys, "tests/1.out")
+			},
+			want: []string{"tests/"},
+		},
+		{
+			name:   "no solutions",
+			change: func(fsys fstest.MapFS) { delete(fsys, "solutions/a.go") },
+			want:   []string{"solutions/"},
+		},
+		{
+			name:   "unknown extension",
+			change: func(fsys fstest.MapFS) { fsys["solutions/a.txt"] = &fstest.MapFile{Data: []byte("x")} },
+			want:   []string{"solutions/a.txt"},
+		},
+		{
+			name:   "duplicate starter code",
+			change: func(fsys fstest.MapFS) { fsys["starter/main.go"] = &fstest.MapFile{Data: []byte("package main\n")} },
+			want:   []string{"starter/main.go"},
+		},
+		{
+			name:   "unexpected file",
+			change: func(fsys fstest.MapFS) { fsys["notes.txt"] = &fstest.MapFile{Data: []byte("x")} },
+			want:   []string{"notes.txt"},
+		},
+		{
+			name:   "not UTF-8",
+			change: func(fsys fstest.MapFS) { fsys["statement.md"] = &fstest.MapFile{Data: []byte{0xff, 0xfe}} },
+			want:   []string{"statement.md", "statement.md"},
+		},
+		{
+			name: "settings for missing test case",
+			change: func(fsys fstest.MapFS) {
+				fsys["problem.yaml"] = &fstest.MapFile{Data: []byte("title: Sum\ndifficulty: easy\ntest_cases:\n  - name: \"9\"\n")}
+			},
+			want: []string{"problem.yaml"},
+		},
+		{
+			name: "weight out of range",
+			change: func(fsys fstest.MapFS) {
+				fsys["problem.yaml"] = &fstest.MapFile{Data: []byte("title: Sum\ndifficulty: easy\ntest_cases:\n  - name: \"1\"\n    weight: 1001\n")}
+			},
+			want: []string{"problem.yaml"},
+		},
+		{
+			name: "harness without placeholder or manifest entry",
+			change: func(fsys fstest.MapFS) {
+				fsys["harness/go.go"] = &fstest.MapFile{Data: []byte("package main\n")}
+			},
+			want: []string{"harness/go.go", "harness/go.go"},
+		},
+		{
+			name: "harness without starter code",
+			change: func(fsys fstest.MapFS) {
+				fsys["problem.yaml"] = &fstest.MapFile{Data: []byte("title: Sum\ndifficulty: easy\nharnesses:\n  - language: python\n    function_name: add\n")}
+				fsys["harness/python.py"] = &fstest.MapFile{Data: []byte("{{solution}}\n")}
+			},
+			want: []string{"harness/python.py"},
+		},
+		{
+			name: "too large",
+			change: func(fsys fstest.MapFS) {
+				fsys["tests/1.in"] = &fstest.MapFile{Data: bytes.Repeat([]byte("1"), maxBytes)}
+			},
+			want: []string{"tests/1.in"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := valid()
+			tt.change(fsys)
+
+			pkg, errs := Read(fsys, maxBytes)
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.File)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Read() errors = %v, want errors in %v", errs, tt.want)
+			}
+			if (pkg == nil) != (len(tt.want) > 0) {
+				t.Errorf("Read() package = %v with errors %v", pkg, errs)
+			}
+		})
+	}
+}
+
+func TestReadDefaults(t *testing.T) {
+	pkg, errs := Read(fstest.MapFS{
+		"problem.yaml":    {Data: []byte("title: Sum\ndifficulty: easy\ntest_cases:\n  - name: \"2\"\n    hidden: true\n")},
+		"statement.md":    {Data: []byte("Add two numbers.")},
+		"tests/10.in":     {Data: []byte("a")},
+		"tests/10.out":    {Data: []byte("a")},
+		"tests/2.in":      {Data: []byte("b")},
+		"tests/2.out":     {Data: []byte("b")},
+		"solutions/a.py":  {Data: []byte("print()")},
+		"solutions/b.cpp": {Data: []byte("int main() {}")},
+	}, maxBytes)
+	if len(errs) > 0 {
+		t.Fatalf("Read() errors = %v", errs)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "numeric test order", got: []string{pkg.TestCases[0].Name, pkg.TestCases[1].Name}, want: []string{"2", "10"}},
+		{name: "hidden from the manifest", got: pkg.TestCases[0].IsHidden, want: true},
+		{name: "visible by default", got: pkg.TestCases[1].IsHidden, want: false},
+		{name: "weight defaults to one", got: []int{pkg.TestCases[0].Weight, pkg.TestCases[1].Weight}, want: []int{1, 1}},
+		{
+			name: "languages from extensions",
+			got:  []string{pkg.ReferenceSolutions[0].Language, pkg.ReferenceSolutions[1].Language},
+			want: []string{"python", "cpp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadZipInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data func() []byte
+	}{
+		{name: "not a zip", data: func() []byte { return []byte("not a zip") }},
+		{
+			name: "empty zip",
+			data: func() []byte {
+				var buf bytes.Buffer
+				_ = zip.NewWriter(&buf).Close()
+				return buf.Bytes()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg, errs := ReadZip(tt.data(), maxBytes)
+			if pkg != nil || len(errs) == 0 {
+				t.Errorf("ReadZip() = %v, %v, want errors", pkg, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package problempkg
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+	"gopkg.in/yaml.v3"
+)
+
+const maxTestCaseWeight = 1000
+
+var errTooLarge = errors.New("package exceeds the uncompressed size limit")
+
+// ReadZip reads a package from a zip archive. See Read.
+func ReadZip(data []byte, maxBytes int64) (*Package, []model.FileError) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, []model.FileError{{Message: "Invalid zip archive: " + err.Error()}}
+	}
+	return Read(zr, maxBytes)
+}
+
+// Read reads and validates a package from fsys. The package may also sit in
+// a single top-level directory, as archivers produce when zipping a folder.
+// maxBytes caps the total size of the files read.
+//
+// Every problem found is reported as a FileError; the package is only
+// returned when there are none.
+func Read(fsys fs.FS, maxBytes int64) (*Package, []model.FileError) {
+	root, err := packageRoot(fsys)
+	if err != nil {
+		return nil, []model.FileError{{Message: err.Error()}}
+	}
+
+	r := &reader{
+		remaining: maxBytes,
+		tests:     make(map[string]string),
+		starter:   make(map[string]string),
+	}
+
+	walkErr := fs.WalkDir(root, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			r.fail(name, err.Error())
+			return nil
+		}
+		if testcases.IgnoredFile(name) {
+			if d.IsDir() && name != "." {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		return r.readFile(root, name)
+	})
+	if errors.Is(walkErr, errTooLarge) {
+		return nil, r.errs
+	}
+
+	r.validate()
+	if len(r.errs) > 0 {
+		sort.SliceStable(r.errs, func(i, j int) bool { return r.errs[i].File < r.errs[j].File })
+		return nil, r.errs
+	}
+	return &r.pkg, nil
+}
+
+// packageRoot returns fsys, or its only top-level directory when the
+// manifest lives there.
+func packageRoot(fsys fs.FS) (fs.FS, error) {
+	if _, err := fs.Stat(fsys, ManifestFile); err == nil {
+		return fsys, nil
+	}
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	for _, e := range entries {
+		if testcases.IgnoredFile(e.Name()) {
+			continue
+		}
+		if !e.IsDir() {
+			return fsys, nil
+		}
+		dirs = append(dirs, e.Name())
+	}
+	if len(dirs) != 1 {
+		return fsys, nil
+	}
+	return fs.Sub(fsys, dirs[0])
+}
+
+type reader struct {
+	pkg          Package
+	errs         []model.FileError
+	remaining    int64
+	haveManifest bool
+	tests        map[string]string
+	// starter maps a language to the file that provides it.
+	starter map[string]string
+}
+
+func (r *reader) fail(file, msg string) {
+	r.errs = append(r.errs, model.FileError{File: file, Message: msg})
+}
+
+func (r *reader) readFile(fsys fs.FS, name string) error {
+	content, err := r.load(fsys, name)
+	if errors.Is(err, errTooLarge) {
+		r.errs = []model.FileError{{File: name, Message: err.Error()}}
+		return err
+	}
+	if err != nil {
+		r.fail(name, err.Error())
+		return nil
+	}
+
+	dir, rest, nested := strings.Cut(name, "/")
+	switch {
+	case name == ManifestFile:
+		r.haveManifest = true
+		dec := yaml.NewDecoder(strings.NewReader(content))
+		dec.KnownFields(true)
+		if err := dec.Decode(&r.pkg.Manifest); err != nil && !errors.Is(err, io.EOF) {
+			r.fail(name, "invalid YAML: "+err.Error())
+		}
+	case name == StatementFile:
+		r.pkg.Statement = content
+	case nested && dir == testsDir:
+		r.tests[rest] = content
+	case nested && dir == solutionsDir:
+		if lang, ok := r.language(name); ok {
+			r.pkg.ReferenceSolutions = append(r.pkg.ReferenceSolutions, SourceFile{Path: name, Language: lang, Code: content})
+		}
+	case nested && dir == starterDir:
+		lang, ok := r.language(name)
+		if !ok {
+			break
+		}
+		if other, dup := r.starter[lang]; dup {
+			r.fail(name, "duplicate starter code for "+lang+", also in "+other)
+			break
+		}
+		r.starter[lang] = name
+		r.pkg.StarterCode = append(r.pkg.StarterCode, SourceFile{Path: name, Language: lang, Code: content})
+	case nested && dir == harnessDir:
+		lang, ok := r.language(name)
+		if !ok {
+			break
+		}
+		if !strings.Contains(content, HarnessPlaceholder) {
+			r.fail(name, "harness template must contain "+HarnessPlaceholder)
+		}
+		r.pkg.Harnesses = append(r.pkg.Harnesses, Harness{Path: name, Language: lang, Template: content})
+	default:
+		r.fail(name, "unexpected file")
+	}
+	return nil
+}
+
+func (r *reader) load(fsys fs.FS, name string) (string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(io.LimitReader(f, r.remaining+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read: %w", err)
+	}
+	if int64(len(content)) > r.remaining {
+		return "", errTooLarge
+	}
+	r.remaining -= int64(len(content))
+
+	if !utf8.Valid(content) {
+		return "", errors.New("file must be valid UTF-8")
+	}
+	return string(content), nil
+}
+
+func (r *reader) language(name string) (string, bool) {
+	lang, ok := LanguageForFile(name)
+	if !ok {
+		r.fail(name, "unknown source file extension; expected one of "+strings.Join(SupportedExtensions(), ", "))
+	}
+	return lang, ok
+}
+
+func (r *reader) validate() {
+	m := &r.pkg.Manifest
+
+	if !r.haveManifest {
+		r.fail(ManifestFile, "missing")
+	} else {
+		if strings.TrimSpace(m.Title) == "" {
+			r.fail(ManifestFile, "title is required")
+		}
+		if m.Difficulty == "" {
+			r.fail(ManifestFile, "difficulty is required")
+		}
+		if m.Visibility != "" && m.Visibility != model.ProblemVisibilityPrivate && m.Visibility != model.ProblemVisibilityPublic {
+			r.fail(ManifestFile, "visibility must be private or public")
+		}
+		if m.TimeLimitMs != 0 && (m.TimeLimitMs < 100 || m.TimeLimitMs > 60000) {
+			r.fail(ManifestFile, "time_limit_ms must be between 100 and 60000")
+		}
+		if m.MemoryLimitKB != 0 && (m.MemoryLimitKB < 1024 || m.MemoryLimitKB > 1048576) {
+			r.fail(ManifestFile, "memory_limit_kb must be between 1024 and 1048576")
+		}
+	}
+
+	if strings.TrimSpace(r.pkg.Statement) == "" {
+		r.fail(StatementFile, "statement is missing or empty")
+	}
+
+	cases, errs := testcases.Pair(r.tests)
+	for _, e := range errs {
+		r.fail(testsDir+"/"+e.File, e.Message)
+	}
+	if len(cases) == 0 && len(errs) == 0 {
+		r.fail(testsDir+"/", "at least one test case is required")
+	}
+
+	settings := make(map[string]TestCaseSettings, len(m.TestCases))
+	for _, s := range m.TestCases {
+		if _, dup := settings[s.Name]; dup {
+			r.fail(ManifestFile, "test_cases: duplicate entry for "+s.Name)
+		}
+		if s.Weight != nil && (*s.Weight < 0 || *s.Weight > maxTestCaseWeight) {
+			r.fail(ManifestFile, fmt.Sprintf("test_cases: weight of %s must be between 0 and %d", s.Name, maxTestCaseWeight))
+		}
+		settings[s.Name] = s
+	}
+
+	for _, tc := range cases {
+		s, ok := settings[tc.Name]
+		delete(settings, tc.Name)
+		weight := 1
+		if ok && s.Weight != nil {
+			weight = *s.Weight
+		}
+		r.pkg.TestCases = append(r.pkg.TestCases, TestCase{
+			Name:           tc.Name,
+			Input:          tc.Input,
+			ExpectedOutput: tc.ExpectedOutput,
+			IsHidden:       s.Hidden,
+			Weight:         weight,
+		})
+	}
+	for name := range settings {
+		r.fail(ManifestFile, "test_cases: no test files named "+name)
+	}
+
+	if len(r.pkg.ReferenceSolutions) == 0 {
+		r.fail(solutionsDir+"/", "at least one reference solution is required")
+	}
+
+	r.validateHarnesses()
+}
+
+// validateHarnesses matches harness files with their manifest entries. A
+// harness language also needs starter code, which is the function stub.
+func (r *reader) validateHarnesses() {
+	functions := make(map[string]string, len(r.pkg.Manifest.Harnesses))
+	for _, h := range r.pkg.Manifest.Harnesses {
+		if _, dup := functions[h.Language]; dup {
+			r.fail(ManifestFile, "harnesses: duplicate entry for "+h.Language)
+		}
+		if strings.TrimSpace(h.FunctionName) == "" {
+			r.fail(ManifestFile, "harnesses: function_name is required for "+h.Language)
+		}
+		functions[h.Language] = h.FunctionName
+	}
+
+	seen := make(map[string]bool, len(r.pkg.Harnesses))
+	for i := range r.pkg.Harnesses {
+		h := &r.pkg.Harnesses[i]
+		if seen[h.Language] {
+			r.fail(h.Path, "duplicate harness for "+h.Language)
+			continue
+		}
+		seen[h.Language] = true
+
+		fn, ok := functions[h.Language]
+		if !ok {
+			r.fail(h.Path, "no entry for "+h.Language+" under harnesses in "+ManifestFile)
+			continue
+		}
+		h.FunctionName = fn
+		if _, ok := r.starter[h.Language]; !ok {
+			r.fail(h.Path, "a harness needs starter code for "+h.Language+" in "+starterDir+"/")
+		}
+	}
+	for lang := range functions {
+		if !seen[lang] {
+			r.fail(ManifestFile, "harnesses: no harness file for "+lang+" in "+harnessDir+"/")
+		}
+	}
+}
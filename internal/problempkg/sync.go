@@ -0,0 +1,198 @@
+package problempkg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+)
+
+// Export builds a package from a stored problem. Test cases are named by
+// their position so the exported order survives a round trip.
+func Export(ctx context.Context, svc problems.Service, problemID int32) (*Package, error) {
+	problemResp, err := svc.GetProblem(ctx, problemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get problem: %w", err)
+	}
+	testCasesResp, err := svc.GetTestCasesByProblemID(ctx, problemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test cases: %w", err)
+	}
+
+	p := problemResp.Problem
+	pkg := &Package{
+		Manifest: Manifest{
+			Title:         p.Title,
+			Difficulty:    p.Difficulty,
+			Tags:          p.Tags,
+			TimeLimitMs:   int(p.TimeLimitMs),
+			MemoryLimitKB: int(p.MemoryLimitKb),
+			Visibility:    p.Visibility,
+		},
+		Statement:          p.Description,
+		ReferenceSolutions: fromSourceFiles(p.ReferenceSolutions),
+		StarterCode:        fromSourceFiles(p.StarterCode),
+	}
+	for _, h := range p.Harnesses {
+		pkg.Harnesses = append(pkg.Harnesses, Harness{
+			Path:         h.Path,
+			Language:     h.Language,
+			FunctionName: h.FunctionName,
+			Template:     h.Template,
+		})
+		pkg.Manifest.Harnesses = append(pkg.Manifest.Harnesses, HarnessSettings{
+			Language:     h.Language,
+			FunctionName: h.FunctionName,
+		})
+	}
+
+	width := max(len(fmt.Sprint(len(testCasesResp.TestCases))), 3)
+	for i, tc := range testCasesResp.TestCases {
+		name := fmt.Sprintf("%0*d", width, i+1)
+		weight := int(tc.Weight)
+		pkg.TestCases = append(pkg.TestCases, TestCase{
+			Name:           name,
+			Input:          tc.Input,
+			ExpectedOutput: tc.ExpectedOutput,
+			IsHidden:       tc.IsHidden,
+			Weight:         weight,
+		})
+		if tc.IsHidden || weight != 1 {
+			pkg.Manifest.TestCases = append(pkg.Manifest.TestCases, TestCaseSettings{
+				Name:   name,
+				Hidden: tc.IsHidden,
+				Weight: &weight,
+			})
+		}
+	}
+	return pkg, nil
+}
+
+// Import stores the package as a new problem owned by companyID, or, when
+// problemID is set, replaces that problem and all of its test cases.
+//
+// The problem and its test cases are saved with separate calls. If saving
+// the test cases of a new problem fails, the problem is deleted again.
+func Import(ctx context.Context, svc problems.Service, companyID, problemID int32, pkg *Package) (*problemspb.Problem, []*problemspb.TestCase, error) {
+	in := pkg.ProblemInput()
+
+	var problem *problemspb.Problem
+	if problemID == 0 {
+		resp, err := svc.CreateProblem(ctx, companyID, in)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create problem: %w", err)
+		}
+		problem = resp.Problem
+	} else {
+		resp, err := svc.UpdateProblem(ctx, problemID, companyID, in)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to update problem: %w", err)
+		}
+		problem = resp.Problem
+	}
+
+	resp, err := svc.BulkCreateTestCases(ctx, problem.Id, companyID, pkg.TestCaseInputs(), true)
+	if err != nil {
+		if problemID == 0 {
+			_, _ = svc.DeleteProblem(context.WithoutCancel(ctx), problem.Id, companyID)
+		}
+		return nil, nil, fmt.Errorf("failed to save test cases: %w", err)
+	}
+	return problem, resp.TestCases, nil
+}
+
+// VerifySolutions runs every reference solution against the package's test
+// cases and reports, per solution file, the cases it does not reproduce.
+func VerifySolutions(ctx context.Context, verifier *testcases.Verifier, pkg *Package) ([]model.FileError, error) {
+	cases := pkg.TestCaseInputs()
+
+	var errs []model.FileError
+	for _, s := range pkg.ReferenceSolutions {
+		failures, err := verifier.Verify(ctx, model.ReferenceSolution{Language: s.Language, Code: s.Code}, cases)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.Path, err)
+		}
+		for _, f := range failures {
+			msg := "wrong output"
+			if f.Error != "" {
+				msg = f.Error
+			}
+			errs = append(errs, model.FileError{
+				File:    s.Path,
+				Message: fmt.Sprintf("test %s: %s", pkg.TestCases[f.TestCaseID-1].Name, strings.TrimSpace(msg)),
+			})
+		}
+	}
+	return errs, nil
+}
+
+// ProblemInput returns the problem fields of the package, including its
+// source files.
+func (p *Package) ProblemInput() problems.ProblemInput {
+	visibility := p.Manifest.Visibility
+	if visibility == "" {
+		visibility = model.ProblemVisibilityPrivate
+	}
+
+	return problems.ProblemInput{
+		Title:         strings.TrimSpace(p.Manifest.Title),
+		Description:   p.Statement,
+		Difficulty:    p.Manifest.Difficulty,
+		Tags:          p.Manifest.Tags,
+		TimeLimitMs:   int32(p.Manifest.TimeLimitMs),
+		MemoryLimitKB: int32(p.Manifest.MemoryLimitKB),
+		Visibility:    visibility,
+		Files: &problems.ProblemFiles{
+			ReferenceSolutions: toSourceFiles(p.ReferenceSolutions),
+			StarterCode:        toSourceFiles(p.StarterCode),
+			Harnesses:          toHarnesses(p.Harnesses),
+		},
+	}
+}
+
+// TestCaseInputs returns the package's test cases in run order.
+func (p *Package) TestCaseInputs() []problems.TestCaseInput {
+	in := make([]problems.TestCaseInput, len(p.TestCases))
+	for i, tc := range p.TestCases {
+		in[i] = problems.TestCaseInput{
+			Input:          tc.Input,
+			ExpectedOutput: tc.ExpectedOutput,
+			IsHidden:       tc.IsHidden,
+			Weight:         int32(tc.Weight),
+		}
+	}
+	return in
+}
+
+func toSourceFiles(files []SourceFile) []*problemspb.SourceFile {
+	out := make([]*problemspb.SourceFile, len(files))
+	for i, f := range files {
+		out[i] = &problemspb.SourceFile{Language: f.Language, Code: f.Code, Path: f.Path}
+	}
+	return out
+}
+
+func toHarnesses(harnesses []Harness) []*problemspb.Harness {
+	out := make([]*problemspb.Harness, len(harnesses))
+	for i, h := range harnesses {
+		out[i] = &problemspb.Harness{
+			Language:     h.Language,
+			FunctionName: h.FunctionName,
+			Template:     h.Template,
+			Path:         h.Path,
+		}
+	}
+	return out
+}
+
+func fromSourceFiles(files []*problemspb.SourceFile) []SourceFile {
+	out := make([]SourceFile, len(files))
+	for i, f := range files {
+		out[i] = SourceFile{Path: f.Path, Language: f.Language, Code: f.Code}
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package problempkg
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+type file struct {
+	name string
+	data []byte
+}
+
+// ExportError lists the files of a problem that a package cannot hold, so
+// that an export never writes a package that fails to read back.
+type ExportError struct {
+	Files []model.FileError
+}
+
+func (e *ExportError) Error() string {
+	msgs := make([]string, len(e.Files))
+	for i, f := range e.Files {
+		msgs[i] = f.File + ": " + f.Message
+	}
+	return "cannot export " + strings.Join(msgs, "; ")
+}
+
+// files lays the package out as it is stored on disk.
+func (p *Package) files() ([]file, error) {
+	manifest, err := yaml.Marshal(p.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", ManifestFile, err)
+	}
+
+	files := []file{
+		{name: ManifestFile, data: manifest},
+		{name: StatementFile, data: []byte(p.Statement)},
+	}
+	for _, tc := range p.TestCases {
+		files = append(files,
+			file{name: path.Join(testsDir, tc.Name+".in"), data: []byte(tc.Input)},
+			file{name: path.Join(testsDir, tc.Name+".out"), data: []byte(tc.ExpectedOutput)},
+		)
+	}
+
+	var errs []model.FileError
+	add := func(stored, dir, base, language, data string) {
+		name := stored
+		if !inDir(name, dir) || !hasExtFor(name, language) {
+			ext, ok := extForLanguage(language)
+			if !ok {
+				errs = append(errs, model.FileError{
+					File:    path.Join(dir, base),
+					Message: fmt.Sprintf("language %q has no file extension; expected one of %s", language, strings.Join(SupportedExtensions(), ", ")),
+				})
+				return
+			}
+			name = path.Join(dir, base+ext)
+		}
+		files = append(files, file{name: name, data: []byte(data)})
+	}
+	for i, s := range p.ReferenceSolutions {
+		add(s.Path, solutionsDir, fmt.Sprintf("solution%d", i+1), s.Language, s.Code)
+	}
+	for _, s := range p.StarterCode {
+		add(s.Path, starterDir, s.Language, s.Language, s.Code)
+	}
+	for _, h := range p.Harnesses {
+		add(h.Path, harnessDir, h.Language, h.Language, h.Template)
+	}
+	if len(errs) > 0 {
+		return nil, &ExportError{Files: errs}
+	}
+	return files, nil
+}
+
+// hasExtFor reports whether name has the extension packages use for
+// language, which is how the language is read back.
+func hasExtFor(name, language string) bool {
+	lang, ok := LanguageForFile(name)
+	return ok && lang == language
+}
+
+// inDir reports whether name is a clean path below dir. Stored paths that
+// are not are replaced when exporting.
+func inDir(name, dir string) bool {
+	return name != "" && path.Clean(name) == name && strings.HasPrefix(name, dir+"/")
+}
+
+// WriteZip writes the package as a zip archive.
+func (p *Package) WriteZip(w io.Writer) error {
+	files, err := p.files()
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", f.name, err)
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	return zw.Close()
+}
+
+// WriteDir writes the package into dir, creating it if needed. The tests,
+// solutions, starter and harness directories are replaced so that files
+// removed from the problem do not linger; anything else in dir is left alone.
+func (p *Package) WriteDir(dir string) error {
+	files, err := p.files()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range []string{testsDir, solutionsDir, starterDir, harnessDir} {
+		if err := os.RemoveAll(filepath.Join(dir, sub)); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range files {
+		name := filepath.Join(dir, filepath.FromSlash(f.name))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, f.data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
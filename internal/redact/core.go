@@ -0,0 +1,60 @@
+package redact
+
+import (
+	"go.uber.org/zap/zapcore"
+)
+
+type core struct {
+	zapcore.Core
+	r *Redactor
+}
+
+// Core wraps a zap core so that every log entry passes through the redactor:
+// string fields with a sensitive key are replaced entirely, other string and
+// error fields and the message have the patterns applied.
+func (r *Redactor) Core(c zapcore.Core) zapcore.Core {
+	if r == nil {
+		return c
+	}
+	return &core{Core: c, r: r}
+}
+
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	return &core{Core: c.Core.With(c.redactFields(fields)), r: c.r}
+}
+
+func (c *core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = c.r.String(ent.Message)
+	return c.Core.Write(ent, c.redactFields(fields))
+}
+
+func (c *core) redactFields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		out[i] = c.redactField(f)
+	}
+	return out
+}
+
+func (c *core) redactField(f zapcore.Field) zapcore.Field {
+	switch f.Type {
+	case zapcore.StringType:
+		if c.r.IsSensitiveKey(f.Key) {
+			f.String = c.r.Value(f.String)
+		} else {
+			f.String = c.r.String(f.String)
+		}
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok && err != nil {
+			return zapcore.Field{Key: f.Key, Type: zapcore.StringType, String: c.r.String(err.Error())}
+		}
+	}
+	return f
+}
@@ -0,0 +1,268 @@
+package redact
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Mask is the placeholder written in place of sensitive values in mask mode.
+const Mask = "[REDACTED]"
+
+const (
+	ModeMask = "mask"
+	ModeHash = "hash"
+)
+
+// Pattern is a named regular expression matching sensitive substrings. If the
+// expression has a capture group named "value", only that group is redacted,
+// which keeps keys such as "password=" readable in the output.
+type Pattern struct {
+	Name  string
+	Regex string
+}
+
+type Config struct {
+	Mode     string
+	HashKey  string
+	Fields   []string
+	Patterns []Pattern
+}
+
+// DefaultFields are the JSON keys redacted when no fields are configured.
+var DefaultFields = []string{
+	"password",
+	"email",
+	"candidate_email",
+	"candidate_name",
+	"code",
+	"submission_code",
+	"api_key",
+	"client_id",
+	"token",
+}
+
+// DefaultPatterns catch secrets that show up in free-form strings such as error
+// messages and non-JSON bodies.
+var DefaultPatterns = []Pattern{
+	{Name: "email", Regex: `[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`},
+	{Name: "bearer_token", Regex: `(?i)bearer\s+(?P<value>[A-Za-z0-9\-._~+/]+=*)`},
+	{Name: "jwt", Regex: `eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`},
+	{Name: "api_key", Regex: `(?i)(?:api[_\-]?key|client[_\-]?id|secret)["']?\s*[:=]\s*["']?(?P<value>[A-Za-z0-9\-_]{8,})`},
+	{Name: "password", Regex: `(?i)password["']?\s*[:=]\s*["']?(?P<value>[^\s"',&}]+)`},
+}
+
+type compiledPattern struct {
+	name  string
+	re    *regexp.Regexp
+	group int
+}
+
+// Redactor removes or hashes sensitive values from request bodies and log
+// fields. A nil *Redactor is valid and leaves every input untouched.
+type Redactor struct {
+	hash     bool
+	hashKey  []byte
+	paths    [][]string
+	keys     map[string]struct{}
+	patterns []compiledPattern
+}
+
+func New(cfg Config) (*Redactor, error) {
+	mode := strings.ToLower(cfg.Mode)
+	switch mode {
+	case "", ModeMask, ModeHash:
+	default:
+		return nil, fmt.Errorf("unknown redaction mode %q", cfg.Mode)
+	}
+	if mode == ModeHash && cfg.HashKey == "" {
+		return nil, fmt.Errorf("redaction hash mode requires a hash key")
+	}
+
+	fields := cfg.Fields
+	if len(fields) == 0 {
+		fields = DefaultFields
+	}
+	patterns := cfg.Patterns
+	if len(patterns) == 0 {
+		patterns = DefaultPatterns
+	}
+
+	r := &Redactor{
+		hash:    mode == ModeHash,
+		hashKey: []byte(cfg.HashKey),
+		keys:    make(map[string]struct{}),
+	}
+
+	for _, f := range fields {
+		segments := strings.Split(strings.ToLower(strings.TrimSpace(f)), ".")
+		if len(segments) == 1 {
+			r.keys[segments[0]] = struct{}{}
+			continue
+		}
+		r.paths = append(r.paths, segments)
+	}
+
+	for _, p := range patterns {
+		re, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("compile redaction pattern %q: %w", p.Name, err)
+		}
+		r.patterns = append(r.patterns, compiledPattern{
+			name:  p.Name,
+			re:    re,
+			group: re.SubexpIndex("value"),
+		})
+	}
+
+	return r, nil
+}
+
+// Value replaces a whole sensitive value. In hash mode the same input always
+// produces the same token so redacted values can still be correlated.
+func (r *Redactor) Value(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+	if !r.hash {
+		return Mask
+	}
+	mac := hmac.New(sha256.New, r.hashKey)
+	mac.Write([]byte(s))
+	return "sha256:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+// String redacts every pattern match inside a free-form string.
+func (r *Redactor) String(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+	for _, p := range r.patterns {
+		if p.group <= 0 {
+			s = p.re.ReplaceAllStringFunc(s, r.Value)
+			continue
+		}
+		s = r.replaceGroup(p, s)
+	}
+	return s
+}
+
+func (r *Redactor) replaceGroup(p compiledPattern, s string) string {
+	matches := p.re.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := m[2*p.group], m[2*p.group+1]
+		if start < 0 {
+			continue
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(r.Value(s[start:end]))
+		last = end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// IsSensitiveKey reports whether a bare field name is configured for
+// redaction, regardless of where it appears in a document.
+func (r *Redactor) IsSensitiveKey(key string) bool {
+	if r == nil {
+		return false
+	}
+	_, ok := r.keys[strings.ToLower(key)]
+	return ok
+}
+
+// JSON redacts configured field paths in a JSON document and applies the
+// string patterns to every remaining string value. Bodies that are not valid
+// JSON are treated as plain text.
+func (r *Redactor) JSON(body []byte) []byte {
+	if r == nil || len(body) == 0 {
+		return body
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return []byte(r.String(string(body)))
+	}
+
+	out, err := json.Marshal(r.walk(doc, nil))
+	if err != nil {
+		return []byte(r.String(string(body)))
+	}
+	return out
+}
+
+// walk descends through decoded JSON. Array indices are not part of the path,
+// so "test_results.input" matches the input of every element.
+func (r *Redactor) walk(v interface{}, path []string) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			childPath := append(path[:len(path):len(path)], strings.ToLower(k))
+			if r.matchPath(childPath) {
+				t[k] = r.redactWhole(child)
+				continue
+			}
+			t[k] = r.walk(child, childPath)
+		}
+		return t
+	case []interface{}:
+		for i, child := range t {
+			t[i] = r.walk(child, path)
+		}
+		return t
+	case string:
+		return r.String(t)
+	default:
+		return v
+	}
+}
+
+func (r *Redactor) redactWhole(v interface{}) interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return r.Value(t)
+	case json.Number:
+		return r.Value(t.String())
+	default:
+		raw, _ := json.Marshal(t)
+		return r.Value(string(raw))
+	}
+}
+
+func (r *Redactor) matchPath(path []string) bool {
+	if _, ok := r.keys[path[len(path)-1]]; ok {
+		return true
+	}
+	for _, rule := range r.paths {
+		if len(rule) != len(path) {
+			continue
+		}
+		matched := true
+		for i, seg := range rule {
+			if seg != "*" && seg != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -15,13 +15,17 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"go-code-runner-microservice/api-gateway/internal/audit"
 	"go-code-runner-microservice/api-gateway/internal/config"
 	"go-code-runner-microservice/api-gateway/internal/logger"
 	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/redact"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testevents"
+	"go-code-runner-microservice/api-gateway/internal/webhook"
 )
 
 func Run() {
@@ -35,11 +39,21 @@ func Run() {
 		panic("failed to load configuration: " + err.Error())
 	}
 
+	// Build the redactor shared by the logger and the logging middleware
+	var redactor *redact.Redactor
+	if cfg.Logging.Redaction.Enabled {
+		redactor, err = redact.New(redactConfig(cfg.Logging.Redaction))
+		if err != nil {
+			panic("failed to configure log redaction: " + err.Error())
+		}
+	}
+
 	// Initialize logger
 	logConfig := logger.Config{
 		Level:       cfg.Logging.Level,
 		Environment: cfg.Logging.Environment,
 		ServiceName: "api-gateway",
+		Redactor:    redactor,
 	}
 	if err := logger.Initialize(logConfig); err != nil {
 		panic("failed to initialize logger: " + err.Error())
@@ -100,8 +114,50 @@ func Run() {
 	defer companyAuthClient.Close()
 	log.Info("connected to company auth service", zap.String("address", cfg.CompanyAuthAddress))
 
+	// Open the audit trail
+	var auditor *audit.Recorder
+	if cfg.Audit.Enabled {
+		sink, err := audit.NewFileSink(cfg.Audit.FilePath, int64(cfg.Audit.MaxSizeMB)*1024*1024, cfg.Audit.MaxBackups)
+		if err != nil {
+			log.Fatal("failed to open audit log",
+				zap.String("path", cfg.Audit.FilePath),
+				zap.Error(err),
+			)
+		}
+		auditor = audit.NewRecorder(sink)
+		defer auditor.Close()
+		log.Info("audit log enabled", zap.String("path", cfg.Audit.FilePath))
+	}
+
+	// Webhook deliveries for job callbacks and company subscriptions
+	webhookQueue, err := webhook.OpenQueue(cfg.Webhooks.QueuePath)
+	if err != nil {
+		log.Fatal("failed to open webhook queue",
+			zap.String("path", cfg.Webhooks.QueuePath),
+			zap.Error(err),
+		)
+	}
+	defer webhookQueue.Close()
+	webhookDispatcher := webhook.NewDispatcher(webhook.Config{
+		MaxAttempts:          cfg.Webhooks.MaxAttempts,
+		InitialBackoff:       time.Duration(cfg.Webhooks.InitialBackoffSeconds) * time.Second,
+		Timeout:              time.Duration(cfg.Webhooks.TimeoutSeconds) * time.Second,
+		AllowPrivateNetworks: cfg.Webhooks.AllowPrivateNetworks,
+	}, webhook.NewLog(companyAuthClient), webhookQueue)
+	resumeCtx, cancelResume := context.WithTimeout(context.Background(), 30*time.Second)
+	webhookDispatcher.Resume(resumeCtx, webhookSecrets(companyAuthClient, cfg.Webhooks.SigningSecret))
+	cancelResume()
+
+	// Relay coding test events to company webhook subscriptions
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	if cfg.Webhooks.TestEvents {
+		go testevents.NewRelay(codingTestsClient, companyAuthClient, webhookDispatcher, cfg.Webhooks.RelayConsumer).Run(relayCtx)
+		log.Info("relaying coding test events to company webhooks", zap.String("consumer", cfg.Webhooks.RelayConsumer))
+	}
+
 	// Create router
-	r := NewRouter(executorClient, problemsClient, codingTestsClient, companyAuthClient)
+	r := NewRouter(cfg, redactor, auditor, executorClient, problemsClient, codingTestsClient, companyAuthClient, webhookDispatcher)
 
 	// Create HTTP server
 	addr := ":" + cfg.ServerPort
@@ -132,6 +188,8 @@ func Run() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	stopRelay()
+
 	log.Info("shutting down HTTP server")
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Error("server forced to shutdown", zap.Error(err))
@@ -139,3 +197,45 @@ func Run() {
 
 	log.Info("server exited successfully")
 }
+
+// webhookSecrets looks up the secrets of deliveries resumed after a
+// restart: the company's callback secret for job callbacks, and the
+// subscription's secret otherwise.
+func webhookSecrets(companyAuthClient *company_auth.Client, signingKey string) webhook.SecretFunc {
+	return func(ctx context.Context, d webhook.Delivery) ([]byte, error) {
+		if d.WebhookID == 0 {
+			if signingKey == "" {
+				return nil, nil
+			}
+			return webhook.CompanySecret([]byte(signingKey), d.CompanyID), nil
+		}
+
+		resp, err := companyAuthClient.ListWebhookSubscriptions(ctx, int32(d.CompanyID))
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Success {
+			return nil, errors.New(resp.GetError())
+		}
+		for _, sub := range resp.Subscriptions {
+			if int(sub.Id) == d.WebhookID {
+				return []byte(sub.Secret), nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+func redactConfig(cfg config.RedactionConfig) redact.Config {
+	patterns := make([]redact.Pattern, len(cfg.Patterns))
+	for i, p := range cfg.Patterns {
+		patterns[i] = redact.Pattern{Name: p.Name, Regex: p.Regex}
+	}
+
+	return redact.Config{
+		Mode:     cfg.Mode,
+		HashKey:  cfg.HashKey,
+		Fields:   cfg.Fields,
+		Patterns: patterns,
+	}
+}
@@ -1,64 +1,224 @@
 package server
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"go-code-runner-microservice/api-gateway/internal/audit"
+	"go-code-runner-microservice/api-gateway/internal/candidate"
+	"go-code-runner-microservice/api-gateway/internal/config"
+	"go-code-runner-microservice/api-gateway/internal/drafts"
 	"go-code-runner-microservice/api-gateway/internal/handler"
+	"go-code-runner-microservice/api-gateway/internal/idempotency"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/limits"
 	"go-code-runner-microservice/api-gateway/internal/middleware"
+	"go-code-runner-microservice/api-gateway/internal/redact"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
 	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go-code-runner-microservice/api-gateway/internal/testcases"
+	"go-code-runner-microservice/api-gateway/internal/testtimer"
+	"go-code-runner-microservice/api-gateway/internal/webhook"
 )
 
 func NewRouter(
+	cfg *config.Config,
+	redactor *redact.Redactor,
+	auditor *audit.Recorder,
 	executorClient *executor.Client,
 	problemsClient *problems.Client,
 	codingTestsClient *coding_tests.Client,
-	companyAuthClient *company_auth.Client) *gin.Engine {
+	companyAuthClient *company_auth.Client,
+	webhookDispatcher *webhook.Dispatcher) *gin.Engine {
 
 	r := gin.New()
 
 	r.Use(middleware.ErrorHandlingMiddleware())
-	r.Use(middleware.LoggingMiddleware())
+	r.Use(middleware.LoggingMiddleware(redactor))
 	r.Use(gin.Recovery())
 
-	config := cors.DefaultConfig()
-	config.AllowOrigins = []string{"http://localhost:5173"}
-	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Correlation-ID"}
-	config.ExposeHeaders = []string{"X-Request-ID", "X-Correlation-ID"}
-	config.AllowCredentials = true
-	r.Use(cors.New(config))
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowOrigins = []string{"http://localhost:5173"}
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Correlation-ID", "Idempotency-Key", "If-None-Match", "X-Admin-Token", "X-Candidate-Token"}
+	corsConfig.ExposeHeaders = []string{"X-Request-ID", "X-Correlation-ID", "Idempotent-Replayed", "ETag"}
+	corsConfig.AllowCredentials = true
+	r.Use(cors.New(corsConfig))
+
+	routeLimits := make(map[string]int64, len(cfg.Limits.Routes))
+	for _, rl := range cfg.Limits.Routes {
+		routeLimits[strings.ToUpper(rl.Method)+" "+rl.Path] = rl.MaxBodyBytes
+	}
+	r.Use(middleware.BodyLimitMiddleware(cfg.Limits.MaxBodyBytes, routeLimits))
+
+	if cfg.HTTPCache.Compression.Enabled {
+		r.Use(middleware.CompressionMiddleware(cfg.HTTPCache.Compression.MinSizeBytes))
+	}
+
+	cachePolicies := make(map[string]string, len(cfg.HTTPCache.Routes))
+	for _, rc := range cfg.HTTPCache.Routes {
+		cachePolicies[rc.Path] = rc.CacheControl
+	}
+	r.Use(middleware.HTTPCacheMiddleware(cachePolicies))
+
+	jobRegistry := jobs.NewRegistry(time.Duration(cfg.Jobs.TTLMinutes) * time.Minute)
+	maxJobWait := time.Duration(cfg.Jobs.MaxWaitSeconds) * time.Second
+
+	jobCallbacks := handler.NewJobCallbacks(executorClient, jobRegistry, webhookDispatcher, cfg.Webhooks.SigningSecret,
+		time.Duration(cfg.Webhooks.JobTimeoutSeconds)*time.Second)
+
+	languageLimits := make(map[string]limits.CodeLimit, len(cfg.Limits.Code.Languages))
+	for lang, l := range cfg.Limits.Code.Languages {
+		languageLimits[lang] = limits.CodeLimit{MaxBytes: l.MaxBytes, MaxLines: l.MaxLines}
+	}
+	codeValidator := limits.NewCodeValidator(
+		limits.CodeLimit{MaxBytes: cfg.Limits.Code.MaxBytes, MaxLines: cfg.Limits.Code.MaxLines},
+		languageLimits,
+	)
+
+	var problemsService problems.Service = problemsClient
+	var cachedProblems *problems.CachedClient
+	if cfg.ProblemsCache.Enabled {
+		cachedProblems = problems.NewCachedClient(
+			problemsClient,
+			time.Duration(cfg.ProblemsCache.TTLSeconds)*time.Second,
+			time.Duration(cfg.ProblemsCache.MaxStaleSeconds)*time.Second,
+			cfg.ProblemsCache.MaxEntries,
+		)
+		problemsService = cachedProblems
+	}
+
+	verifier := testcases.NewVerifier(executorClient, time.Duration(cfg.TestCases.ValidationTimeoutSeconds)*time.Second)
 
 	r.GET("/health", handler.MakeHealthHandler())
 
 	v1 := r.Group("/api/v1")
 	{
-		v1.POST("/execute", handler.MakeExecuteHandler(executorClient))
-		v1.GET("/execute/job/:job_id", handler.MakeJobStatusHandler(executorClient))
+		optionalCompanyAuth := middleware.OptionalCompanyAuthMiddleware(companyAuthClient)
+		requireCompanyAuth := middleware.CompanyAuthMiddleware(companyAuthClient)
+		session := middleware.SessionMiddleware([]byte(cfg.Session.Secret))
+
+		// Starting a test issues the candidate token its other routes require
+		candidateTokens := candidate.NewIssuer([]byte(cfg.Candidate.TokenSecret), time.Duration(cfg.Candidate.TokenTTLMinutes)*time.Minute)
+		optionalCandidateAuth := middleware.OptionalCandidateAuthMiddleware(candidateTokens)
+		requireCandidateAuth := middleware.CandidateAuthMiddleware(candidateTokens)
+		candidateToken := middleware.OptionalCandidateTokenMiddleware(candidateTokens)
 
-		v1.GET("/problems", handler.MakeListProblemsHandler(problemsClient))
-		v1.GET("/problems/:id", handler.MakeGetProblemHandler(problemsClient))
-		v1.GET("/problems/:id/test-cases", handler.MakeGetTestCasesByProblemIDHandler(problemsClient))
+		// Retried POSTs are deduplicated per authenticated caller, so the
+		// idempotency middleware goes after each route's auth middlewares
+		idempotencyStore := idempotency.NewStore(time.Duration(cfg.Idempotency.TTLMinutes) * time.Minute)
+		idempotent := middleware.IdempotencyMiddleware(idempotencyStore, time.Duration(cfg.Idempotency.WaitTimeoutSeconds)*time.Second)
+
+		// Jobs belong to the company, candidate or anonymous session that
+		// submitted them
+		v1.POST("/execute", optionalCompanyAuth, session, idempotent, handler.MakeExecuteHandler(executorClient, problemsService, codeValidator, jobRegistry, jobCallbacks, maxJobWait))
+		v1.POST("/execute/run", optionalCompanyAuth, session, idempotent, handler.MakeRunHandler(executorClient, problemsService, codeValidator, jobRegistry, maxJobWait))
+		v1.GET("/execute/job/:job_id", optionalCompanyAuth, candidateToken, session, handler.MakeJobStatusHandler(executorClient, jobRegistry, maxJobWait))
+		v1.DELETE("/execute/job/:job_id", optionalCompanyAuth, candidateToken, session, handler.MakeCancelJobHandler(executorClient, jobRegistry))
+		v1.POST("/execute/batch", requireCompanyAuth, idempotent, handler.MakeBatchExecuteHandler(executorClient, problemsService, codeValidator, jobRegistry, auditor, cfg.Jobs.MaxBatchItems, cfg.Jobs.BatchConcurrency))
+		v1.GET("/execute/batch/:batch_id", requireCompanyAuth, handler.MakeBatchStatusHandler(executorClient, jobRegistry, cfg.Jobs.BatchConcurrency))
+
+		// Webhook delivery log for job callbacks and company subscriptions
+		v1.GET("/webhooks/deliveries", requireCompanyAuth, handler.MakeListWebhookDeliveriesHandler(webhookDispatcher.Log()))
+		v1.GET("/webhooks/deliveries/:delivery_id", requireCompanyAuth, handler.MakeGetWebhookDeliveryHandler(webhookDispatcher.Log()))
+
+		v1.GET("/problems", optionalCompanyAuth, handler.MakeListProblemsHandler(problemsService))
+		v1.GET("/problems/:id", optionalCompanyAuth, handler.MakeGetProblemHandler(problemsService))
+		v1.GET("/problems/:id/test-cases", optionalCompanyAuth, handler.MakeGetTestCasesByProblemIDHandler(problemsService))
+		v1.GET("/problems/:id/starter", optionalCompanyAuth, handler.MakeGetStarterCodeHandler(problemsService))
+
+		// Problem authoring routes for companies
+		v1.POST("/problems", requireCompanyAuth, idempotent, handler.MakeCreateProblemHandler(problemsService, auditor))
+		v1.PUT("/problems/:id", requireCompanyAuth, handler.MakeUpdateProblemHandler(problemsService, auditor))
+		v1.DELETE("/problems/:id", requireCompanyAuth, handler.MakeDeleteProblemHandler(problemsService, auditor))
+
+		// Problem packages, for keeping problems in version control
+		importProblem := handler.MakeImportProblemHandler(problemsService, verifier, codeValidator, auditor, cfg.TestCases.MaxArchiveBytes)
+		v1.GET("/problems/:id/export", requireCompanyAuth, handler.MakeExportProblemHandler(problemsService, auditor))
+		v1.POST("/problems/import", requireCompanyAuth, idempotent, importProblem)
+		v1.PUT("/problems/:id/import", requireCompanyAuth, importProblem)
+
+		// Problem versions; coding tests pin the version they were generated with
+		v1.GET("/problems/:id/versions", requireCompanyAuth, handler.MakeListProblemVersionsHandler(problemsService))
+		v1.GET("/problems/:id/versions/diff", requireCompanyAuth, handler.MakeDiffProblemVersionsHandler(problemsService))
+		v1.GET("/problems/:id/versions/:version", requireCompanyAuth, handler.MakeGetProblemVersionHandler(problemsService))
+
+		// Test case management for company-owned problems
+		v1.POST("/problems/:id/test-cases", requireCompanyAuth, idempotent, handler.MakeCreateTestCaseHandler(problemsService, verifier, codeValidator, auditor))
+		v1.POST("/problems/:id/test-cases/bulk", requireCompanyAuth, idempotent, handler.MakeBulkUploadTestCasesHandler(problemsService, verifier, codeValidator, auditor, cfg.TestCases.MaxBulkCases, cfg.TestCases.MaxArchiveBytes))
+		v1.POST("/problems/:id/test-cases/reorder", requireCompanyAuth, idempotent, handler.MakeReorderTestCasesHandler(problemsService, auditor))
+		v1.PUT("/problems/:id/test-cases/:case_id", requireCompanyAuth, handler.MakeUpdateTestCaseHandler(problemsService, verifier, codeValidator, auditor))
+		v1.PATCH("/problems/:id/test-cases/:case_id", requireCompanyAuth, handler.MakePatchTestCaseHandler(problemsService, auditor))
+		v1.DELETE("/problems/:id/test-cases/:case_id", requireCompanyAuth, handler.MakeDeleteTestCaseHandler(problemsService, auditor))
+
+		// Tests are submitted automatically when their time runs out
+		submitGrace := time.Duration(cfg.CodingTests.SubmitGraceSeconds) * time.Second
+		var testTimer *testtimer.Scheduler
+		if cfg.CodingTests.AutoSubmit {
+			autoSubmitter := testtimer.NewAutoSubmitter(codingTestsClient, executorClient, problemsService, time.Duration(cfg.CodingTests.AutoSubmitTimeoutSeconds)*time.Second)
+			testTimer = testtimer.NewScheduler(autoSubmitter.Submit, submitGrace)
+		}
+		gradeTimeout := time.Duration(cfg.CodingTests.GradeTimeoutSeconds) * time.Second
+		draftThrottle := drafts.NewThrottle(time.Duration(cfg.CodingTests.DraftIntervalSeconds) * time.Second)
 
 		codingTests := v1.Group("/tests")
 		{
-			codingTests.GET("/:test_id/verify", handler.MakeVerifyTestHandler(codingTestsClient))
-			codingTests.POST("/:test_id/start", handler.MakeStartTestHandler(codingTestsClient))
-			codingTests.POST("/:test_id/submit", handler.MakeSubmitTestHandler(codingTestsClient))
-			codingTests.POST("/generate", handler.MakeGenerateTestHandler(codingTestsClient))
-			codingTests.GET("/company/:company_id", handler.MakeGetCompanyTestsHandler(codingTestsClient))
+			codingTests.GET("/:test_id/verify", optionalCandidateAuth, handler.MakeVerifyTestHandler(codingTestsClient))
+			codingTests.POST("/:test_id/start", optionalCandidateAuth, idempotent, handler.MakeStartTestHandler(codingTestsClient, candidateTokens, testTimer))
+			codingTests.POST("/:test_id/token", requireCandidateAuth, idempotent, handler.MakeRefreshCandidateTokenHandler(codingTestsClient, candidateTokens))
+			codingTests.POST("/:test_id/candidate-token", requireCompanyAuth, idempotent, handler.MakeReissueCandidateTokenHandler(codingTestsClient, candidateTokens, auditor))
+			codingTests.POST("/:test_id/submit", requireCandidateAuth, idempotent, handler.MakeSubmitTestHandler(codingTestsClient, executorClient, problemsService, codeValidator, testTimer, submitGrace, gradeTimeout))
+			codingTests.POST("/:test_id/problems/:problem_id/submit", requireCandidateAuth, idempotent, handler.MakeSubmitProblemHandler(codingTestsClient, executorClient, problemsService, codeValidator, submitGrace, gradeTimeout))
+			codingTests.PUT("/:test_id/draft", requireCandidateAuth, handler.MakeSaveDraftHandler(codingTestsClient, codeValidator, draftThrottle, testTimer))
+			codingTests.GET("/:test_id/draft", requireCandidateAuth, handler.MakeGetDraftHandler(codingTestsClient))
+			codingTests.GET("/:test_id/time-remaining", requireCandidateAuth, handler.MakeTestTimeRemainingHandler(codingTestsClient, testTimer))
+			codingTests.GET("/:test_id/problem", requireCandidateAuth, handler.MakeGetTestProblemHandler(codingTestsClient, problemsService))
+			codingTests.GET("/:test_id/starter", requireCandidateAuth, handler.MakeGetTestStarterCodeHandler(codingTestsClient, problemsService))
+			codingTests.POST("/:test_id/execute", requireCandidateAuth, idempotent, handler.MakeExecuteTestHandler(codingTestsClient, executorClient, codeValidator, jobRegistry, testTimer))
+			codingTests.POST("/generate", requireCompanyAuth, idempotent, handler.MakeGenerateTestHandler(codingTestsClient, problemsService, auditor))
+			codingTests.GET("/company/:company_id", requireCompanyAuth, handler.MakeGetCompanyTestsHandler(codingTestsClient))
 		}
 
 		// Company authentication routes
-		companyHandler := handler.NewCompanyHandler(companyAuthClient)
+		companyHandler := handler.NewCompanyHandler(companyAuthClient, auditor)
+		webhookHandler := handler.NewCompanyWebhookHandler(companyAuthClient, webhookDispatcher, cfg.Webhooks.SigningSecret, auditor)
+		templateHandler := handler.NewAssessmentTemplateHandler(codingTestsClient, problemsService, auditor)
 		companies := v1.Group("/companies")
 		{
 			companies.POST("/register", companyHandler.Register)
 			companies.POST("/login", companyHandler.Login)
-			companies.POST("/api-key", companyHandler.GenerateAPIKey)
-			companies.POST("/client-id", companyHandler.GenerateClientID)
+			companies.POST("/api-key", requireCompanyAuth, idempotent, companyHandler.GenerateAPIKey)
+			companies.POST("/client-id", requireCompanyAuth, idempotent, companyHandler.GenerateClientID)
+			companies.GET("/audit", requireCompanyAuth, handler.MakeListAuditEventsHandler(auditor))
+
+			// Webhook subscriptions for coding test lifecycle events
+			companies.POST("/webhooks", requireCompanyAuth, idempotent, webhookHandler.Create)
+			companies.GET("/webhooks", requireCompanyAuth, webhookHandler.List)
+			companies.DELETE("/webhooks/:webhook_id", requireCompanyAuth, webhookHandler.Delete)
+			companies.POST("/webhooks/:webhook_id/ping", requireCompanyAuth, idempotent, webhookHandler.Ping)
+			companies.GET("/webhooks/dead-letters", requireCompanyAuth, webhookHandler.ListDeadLetters)
+			companies.GET("/webhooks/callback-secret", requireCompanyAuth, webhookHandler.CallbackSecret)
+			companies.POST("/webhooks/deliveries/:delivery_id/redeliver", requireCompanyAuth, idempotent, webhookHandler.Redeliver)
+
+			// Reusable assessment templates that tests can be generated from
+			companies.POST("/templates", requireCompanyAuth, idempotent, templateHandler.Create)
+			companies.GET("/templates", requireCompanyAuth, templateHandler.List)
+			companies.GET("/templates/:template_id", requireCompanyAuth, templateHandler.Get)
+			companies.PUT("/templates/:template_id", requireCompanyAuth, templateHandler.Update)
+			companies.DELETE("/templates/:template_id", requireCompanyAuth, templateHandler.Delete)
+		}
+
+		// Operator routes
+		admin := v1.Group("/admin", middleware.AdminAuthMiddleware(cfg.Admin.Token))
+		{
+			if cachedProblems != nil {
+				admin.DELETE("/cache/problems", handler.MakePurgeAllProblemsCacheHandler(cachedProblems))
+				admin.DELETE("/cache/problems/:id", handler.MakePurgeProblemCacheHandler(cachedProblems))
+			}
 		}
 	}
 
@@ -0,0 +1,67 @@
+package coding_tests
+
+import (
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+)
+
+// IsAssessment reports whether test is made up of several problems.
+func IsAssessment(test *codingtestspb.CodingTest) bool {
+	return len(test.Problems) > 0
+}
+
+// Problems returns the problems of a test. A single-problem test has one
+// problem of weight 1.
+func Problems(test *codingtestspb.CodingTest) []*codingtestspb.AssessmentProblem {
+	if IsAssessment(test) {
+		return test.Problems
+	}
+	return []*codingtestspb.AssessmentProblem{{
+		ProblemId:      test.ProblemId,
+		ProblemVersion: test.ProblemVersion,
+		Weight:         1,
+	}}
+}
+
+// Problem returns the problem of test with the given ID.
+func Problem(test *codingtestspb.CodingTest, problemID int32) (*codingtestspb.AssessmentProblem, bool) {
+	for _, p := range Problems(test) {
+		if p.ProblemId == problemID {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// WeightedScore returns the total score of an assessment with the given
+// submissions: the mean of the problem scores weighted by problem weight,
+// rounded down. Problems without a submission score zero, and a weight of
+// zero counts as one.
+func WeightedScore(test *codingtestspb.CodingTest, submissions []*codingtestspb.ProblemSubmission) int {
+	scores := make(map[int32]int32, len(submissions))
+	for _, s := range submissions {
+		scores[s.ProblemId] = s.PassedPercentage
+	}
+
+	var total, weights int
+	for _, p := range Problems(test) {
+		weight := int(max(p.Weight, 1))
+		total += weight * int(scores[p.ProblemId])
+		weights += weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return total / weights
+}
+
+// WithSubmission returns submissions with sub replacing any earlier
+// submission for the same problem.
+func WithSubmission(submissions []*codingtestspb.ProblemSubmission, sub *codingtestspb.ProblemSubmission) []*codingtestspb.ProblemSubmission {
+	out := make([]*codingtestspb.ProblemSubmission, 0, len(submissions)+1)
+	for _, s := range submissions {
+		if s.ProblemId != sub.ProblemId {
+			out = append(out, s)
+		}
+	}
+	return append(out, sub)
+}
@@ -0,0 +1,159 @@
+package coding_tests
+
+import (
+	"reflect"
+	"testing"
+
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+)
+
+func TestWeightedScore(t *testing.T) {
+	assessment := &codingtestspb.CodingTest{
+		Problems: []*codingtestspb.AssessmentProblem{
+			{ProblemId: 1, Weight: 1},
+			{ProblemId: 2, Weight: 3},
+		},
+	}
+	unweighted := &codingtestspb.CodingTest{
+		Problems: []*codingtestspb.AssessmentProblem{
+			{ProblemId: 1},
+			{ProblemId: 2},
+		},
+	}
+	single := &codingtestspb.CodingTest{ProblemId: 5}
+
+	tests := []struct {
+		name        string
+		test        *codingtestspb.CodingTest
+		submissions []*codingtestspb.ProblemSubmission
+		want        int
+	}{
+		{name: "nothing submitted", test: assessment, want: 0},
+		{
+			name:        "all passed",
+			test:        assessment,
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 100), submission(2, 100)},
+			want:        100,
+		},
+		{
+			name:        "weighted mean",
+			test:        assessment,
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 100), submission(2, 50)},
+			want:        62,
+		},
+		{
+			name:        "missing problem scores zero",
+			test:        assessment,
+			submissions: []*codingtestspb.ProblemSubmission{submission(2, 100)},
+			want:        75,
+		},
+		{
+			name:        "problems outside the test are ignored",
+			test:        assessment,
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 100), submission(9, 100)},
+			want:        25,
+		},
+		{
+			name:        "zero weights count as one",
+			test:        unweighted,
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 80), submission(2, 41)},
+			want:        60,
+		},
+		{
+			name:        "single-problem test",
+			test:        single,
+			submissions: []*codingtestspb.ProblemSubmission{submission(5, 70)},
+			want:        70,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeightedScore(tt.test, tt.submissions); got != tt.want {
+				t.Errorf("WeightedScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProblems(t *testing.T) {
+	tests := []struct {
+		name string
+		test *codingtestspb.CodingTest
+		want []int32
+	}{
+		{
+			name: "single-problem test",
+			test: &codingtestspb.CodingTest{ProblemId: 5, ProblemVersion: 2},
+			want: []int32{5},
+		},
+		{
+			name: "assessment",
+			test: &codingtestspb.CodingTest{Problems: []*codingtestspb.AssessmentProblem{{ProblemId: 3}, {ProblemId: 1}}},
+			want: []int32{3, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int32
+			for _, p := range Problems(tt.test) {
+				got = append(got, p.ProblemId)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Problems() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	single := Problems(&codingtestspb.CodingTest{ProblemId: 5, ProblemVersion: 2})[0]
+	if single.ProblemVersion != 2 || single.Weight != 1 {
+		t.Errorf("single problem = %+v, want version 2 and weight 1", single)
+	}
+}
+
+func TestWithSubmission(t *testing.T) {
+	tests := []struct {
+		name        string
+		submissions []*codingtestspb.ProblemSubmission
+		sub         *codingtestspb.ProblemSubmission
+		want        map[int32]int32
+	}{
+		{name: "first", sub: submission(1, 40), want: map[int32]int32{1: 40}},
+		{
+			name:        "other problem",
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 40)},
+			sub:         submission(2, 90),
+			want:        map[int32]int32{1: 40, 2: 90},
+		},
+		{
+			name:        "replaces the same problem",
+			submissions: []*codingtestspb.ProblemSubmission{submission(1, 40), submission(2, 90)},
+			sub:         submission(1, 100),
+			want:        map[int32]int32{1: 100, 2: 90},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(tt.submissions)
+			got := make(map[int32]int32)
+			for _, s := range WithSubmission(tt.submissions, tt.sub) {
+				if _, dup := got[s.ProblemId]; dup {
+					t.Fatalf("problem %d is listed twice", s.ProblemId)
+				}
+				got[s.ProblemId] = s.PassedPercentage
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithSubmission() = %v, want %v", got, tt.want)
+			}
+			if len(tt.submissions) != before {
+				t.Error("WithSubmission() changed its input")
+			}
+		})
+	}
+}
+
+func submission(problemID, passed int32) *codingtestspb.ProblemSubmission {
+	return &codingtestspb.ProblemSubmission{ProblemId: problemID, PassedPercentage: passed}
+}
@@ -69,12 +69,87 @@ func (c *Client) SubmitTest(ctx context.Context, testID, code string, passedPerc
 	return c.client.SubmitTest(ctx, req)
 }
 
-func (c *Client) GenerateTest(ctx context.Context, companyID, problemID, expiresInHours int32, clientId string) (*codingtestspb.GenerateTestResponse, error) {
+func (c *Client) SubmitProblem(ctx context.Context, testID string, problemID int32, language, code string, passedPercentage int32) (*codingtestspb.SubmitProblemResponse, error) {
+	req := &codingtestspb.SubmitProblemRequest{
+		TestId:           testID,
+		ProblemId:        problemID,
+		Language:         language,
+		Code:             code,
+		PassedPercentage: passedPercentage,
+	}
+
+	return c.client.SubmitProblem(ctx, req)
+}
+
+// AutoSubmitTest submits a test whose time has run out.
+func (c *Client) AutoSubmitTest(ctx context.Context, testID, code string, passedPercentage int32) (*codingtestspb.AutoSubmitTestResponse, error) {
+	req := &codingtestspb.AutoSubmitTestRequest{
+		TestId:           testID,
+		Code:             code,
+		PassedPercentage: passedPercentage,
+	}
+
+	return c.client.AutoSubmitTest(ctx, req)
+}
+
+// SaveDraft saves a draft of a problem of a test; problemID is zero for
+// single-problem tests.
+func (c *Client) SaveDraft(ctx context.Context, testID string, problemID int32, language, mode, code string) (*codingtestspb.SaveDraftResponse, error) {
+	req := &codingtestspb.SaveDraftRequest{
+		TestId:    testID,
+		ProblemId: problemID,
+		Language:  language,
+		Mode:      mode,
+		Code:      code,
+	}
+
+	return c.client.SaveDraft(ctx, req)
+}
+
+// GetDraft returns a version of the draft of a problem of a test; version
+// zero returns the latest.
+func (c *Client) GetDraft(ctx context.Context, testID string, problemID, version int32) (*codingtestspb.GetDraftResponse, error) {
+	req := &codingtestspb.GetDraftRequest{
+		TestId:    testID,
+		ProblemId: problemID,
+		Version:   version,
+	}
+
+	return c.client.GetDraft(ctx, req)
+}
+
+// GenerateTestOptions selects what a generated test is about: one problem,
+// or the Problems of a multi-problem assessment.
+type GenerateTestOptions struct {
+	ProblemID int32
+	// ProblemVersion pins the problem; zero pins its current version.
+	ProblemVersion int32
+	Problems       []*codingtestspb.AssessmentProblem
+	ExpiresInHours int32
+	// TestDurationMinutes is the overall time limit; zero uses the
+	// service default.
+	TestDurationMinutes int32
+	// TemplateID records the template the options were taken from.
+	TemplateID       int32
+	AllowedLanguages []string
+	Instructions     string
+	PassingThreshold int32
+}
+
+// GenerateTest creates a test link for a company.
+func (c *Client) GenerateTest(ctx context.Context, companyID int32, clientId string, opts GenerateTestOptions) (*codingtestspb.GenerateTestResponse, error) {
 	req := &codingtestspb.GenerateTestRequest{
-		CompanyId:      companyID,
-		ProblemId:      problemID,
-		ExpiresInHours: expiresInHours,
-		ClientId:       clientId,
+		CompanyId:           companyID,
+		ProblemId:           opts.ProblemID,
+		ExpiresInHours:      opts.ExpiresInHours,
+		ClientId:            clientId,
+		ProblemVersion:      opts.ProblemVersion,
+		Problems:            opts.Problems,
+		TestDurationMinutes: opts.TestDurationMinutes,
+		TemplateId:          opts.TemplateID,
+		AllowedLanguages:    opts.AllowedLanguages,
+		Instructions:        opts.Instructions,
+		PassingThreshold:    opts.PassingThreshold,
 	}
 
 	return c.client.GenerateTest(ctx, req)
@@ -87,3 +162,67 @@ func (c *Client) GetCompanyTests(ctx context.Context, companyID int32) (*codingt
 
 	return c.client.GetCompanyTests(ctx, req)
 }
+
+// WatchTestEvents opens a stream of test lifecycle events after the last
+// event consumer acknowledged. The stream ends when ctx is cancelled.
+func (c *Client) WatchTestEvents(ctx context.Context, consumer string) (codingtestspb.CodingTestService_WatchTestEventsClient, error) {
+	req := &codingtestspb.WatchTestEventsRequest{
+		Consumer: consumer,
+	}
+
+	return c.client.WatchTestEvents(ctx, req)
+}
+
+// AckTestEvent records that consumer has handled the events up to eventID.
+func (c *Client) AckTestEvent(ctx context.Context, consumer, eventID string) (*codingtestspb.AckTestEventResponse, error) {
+	req := &codingtestspb.AckTestEventRequest{
+		Consumer: consumer,
+		EventId:  eventID,
+	}
+
+	return c.client.AckTestEvent(ctx, req)
+}
+
+// CreateAssessmentTemplate stores a new template for the company set on t.
+func (c *Client) CreateAssessmentTemplate(ctx context.Context, t *codingtestspb.AssessmentTemplate) (*codingtestspb.CreateAssessmentTemplateResponse, error) {
+	req := &codingtestspb.CreateAssessmentTemplateRequest{
+		Template: t,
+	}
+
+	return c.client.CreateAssessmentTemplate(ctx, req)
+}
+
+func (c *Client) GetAssessmentTemplate(ctx context.Context, id, companyID int32) (*codingtestspb.GetAssessmentTemplateResponse, error) {
+	req := &codingtestspb.GetAssessmentTemplateRequest{
+		Id:        id,
+		CompanyId: companyID,
+	}
+
+	return c.client.GetAssessmentTemplate(ctx, req)
+}
+
+func (c *Client) ListAssessmentTemplates(ctx context.Context, companyID int32) (*codingtestspb.ListAssessmentTemplatesResponse, error) {
+	req := &codingtestspb.ListAssessmentTemplatesRequest{
+		CompanyId: companyID,
+	}
+
+	return c.client.ListAssessmentTemplates(ctx, req)
+}
+
+// UpdateAssessmentTemplate replaces the template with t's ID and company.
+func (c *Client) UpdateAssessmentTemplate(ctx context.Context, t *codingtestspb.AssessmentTemplate) (*codingtestspb.UpdateAssessmentTemplateResponse, error) {
+	req := &codingtestspb.UpdateAssessmentTemplateRequest{
+		Template: t,
+	}
+
+	return c.client.UpdateAssessmentTemplate(ctx, req)
+}
+
+func (c *Client) DeleteAssessmentTemplate(ctx context.Context, id, companyID int32) (*codingtestspb.DeleteAssessmentTemplateResponse, error) {
+	req := &codingtestspb.DeleteAssessmentTemplateRequest{
+		Id:        id,
+		CompanyId: companyID,
+	}
+
+	return c.client.DeleteAssessmentTemplate(ctx, req)
+}
@@ -0,0 +1,128 @@
+package coding_tests
+
+import (
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+// ToCodingTest converts a coding test, leaving unset optional fields nil.
+func ToCodingTest(t *codingtestspb.CodingTest) model.CodingTest {
+	test := model.CodingTest{
+		ID:                  t.Id,
+		CompanyID:           int(t.CompanyId),
+		ProblemID:           int(t.ProblemId),
+		ProblemVersion:      int(t.ProblemVersion),
+		Status:              t.Status,
+		TestDurationMinutes: int(t.TestDurationMinutes),
+	}
+
+	if t.CandidateName != "" {
+		candidateName := t.CandidateName
+		test.CandidateName = &candidateName
+	}
+	if t.CandidateEmail != "" {
+		candidateEmail := t.CandidateEmail
+		test.CandidateEmail = &candidateEmail
+	}
+	if t.SubmissionCode != "" {
+		submissionCode := t.SubmissionCode
+		test.SubmissionCode = &submissionCode
+	}
+	if t.PassedPercentage != 0 {
+		passedPercentage := int(t.PassedPercentage)
+		test.PassedPercentage = &passedPercentage
+	}
+
+	if t.StartedAt != nil {
+		startedAt := t.StartedAt.AsTime()
+		test.StartedAt = &startedAt
+	}
+	if t.CompletedAt != nil {
+		completedAt := t.CompletedAt.AsTime()
+		test.CompletedAt = &completedAt
+	}
+	if t.ExpiresAt != nil {
+		test.ExpiresAt = t.ExpiresAt.AsTime()
+	}
+	if t.CreatedAt != nil {
+		test.CreatedAt = t.CreatedAt.AsTime()
+	}
+	if t.UpdatedAt != nil {
+		test.UpdatedAt = t.UpdatedAt.AsTime()
+	}
+
+	test.Problems = toAssessmentProblems(t.Problems)
+	for _, s := range t.Submissions {
+		test.Submissions = append(test.Submissions, ToProblemSubmission(s))
+	}
+
+	test.TemplateID = int(t.TemplateId)
+	test.AllowedLanguages = t.AllowedLanguages
+	test.Instructions = t.Instructions
+	test.PassingThreshold = int(t.PassingThreshold)
+	if t.CompletedAt != nil && t.PassingThreshold > 0 {
+		passed := t.PassedPercentage >= t.PassingThreshold
+		test.Passed = &passed
+	}
+	return test
+}
+
+func toAssessmentProblems(problems []*codingtestspb.AssessmentProblem) []model.AssessmentProblem {
+	var out []model.AssessmentProblem
+	for _, p := range problems {
+		out = append(out, model.AssessmentProblem{
+			ProblemID:      int(p.ProblemId),
+			ProblemVersion: int(p.ProblemVersion),
+			Weight:         int(p.Weight),
+		})
+	}
+	return out
+}
+
+func ToAssessmentTemplate(t *codingtestspb.AssessmentTemplate) model.AssessmentTemplate {
+	tmpl := model.AssessmentTemplate{
+		ID:                  int(t.Id),
+		CompanyID:           int(t.CompanyId),
+		Name:                t.Name,
+		Problems:            toAssessmentProblems(t.Problems),
+		TestDurationMinutes: int(t.TestDurationMinutes),
+		ExpiresInHours:      int(t.ExpiresInHours),
+		AllowedLanguages:    t.AllowedLanguages,
+		Instructions:        t.Instructions,
+		PassingThreshold:    int(t.PassingThreshold),
+	}
+	if t.CreatedAt != nil {
+		tmpl.CreatedAt = t.CreatedAt.AsTime()
+	}
+	if t.UpdatedAt != nil {
+		tmpl.UpdatedAt = t.UpdatedAt.AsTime()
+	}
+	return tmpl
+}
+
+func ToProblemSubmission(s *codingtestspb.ProblemSubmission) model.ProblemSubmission {
+	sub := model.ProblemSubmission{
+		ProblemID:        int(s.ProblemId),
+		Language:         s.Language,
+		Code:             s.Code,
+		PassedPercentage: int(s.PassedPercentage),
+	}
+	if s.SubmittedAt != nil {
+		sub.SubmittedAt = s.SubmittedAt.AsTime()
+	}
+	return sub
+}
+
+func ToDraft(d *codingtestspb.Draft) model.Draft {
+	draft := model.Draft{
+		Version:   int(d.Version),
+		ProblemID: int(d.ProblemId),
+		Language:  d.Language,
+		Mode:      d.Mode,
+		Code:      d.Code,
+	}
+	if d.SavedAt != nil {
+		draft.SavedAt = d.SavedAt.AsTime()
+	}
+	return draft
+}
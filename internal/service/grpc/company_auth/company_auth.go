@@ -75,3 +75,59 @@ func (c *Client) GenerateClientID(ctx context.Context, companyID int32) (*compan
 
 	return c.client.GenerateClientID(ctx, req)
 }
+
+func (c *Client) ValidateToken(ctx context.Context, token string) (*companyauthpb.ValidateTokenResponse, error) {
+	req := &companyauthpb.ValidateTokenRequest{
+		Token: token,
+	}
+
+	return c.client.ValidateToken(ctx, req)
+}
+
+func (c *Client) CreateWebhookSubscription(ctx context.Context, companyID int32, url string, events []string) (*companyauthpb.CreateWebhookSubscriptionResponse, error) {
+	req := &companyauthpb.CreateWebhookSubscriptionRequest{
+		CompanyId: companyID,
+		Url:       url,
+		Events:    events,
+	}
+
+	return c.client.CreateWebhookSubscription(ctx, req)
+}
+
+func (c *Client) ListWebhookSubscriptions(ctx context.Context, companyID int32) (*companyauthpb.ListWebhookSubscriptionsResponse, error) {
+	req := &companyauthpb.ListWebhookSubscriptionsRequest{
+		CompanyId: companyID,
+	}
+
+	return c.client.ListWebhookSubscriptions(ctx, req)
+}
+
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, id, companyID int32) (*companyauthpb.DeleteWebhookSubscriptionResponse, error) {
+	req := &companyauthpb.DeleteWebhookSubscriptionRequest{
+		Id:        id,
+		CompanyId: companyID,
+	}
+
+	return c.client.DeleteWebhookSubscription(ctx, req)
+}
+
+func (c *Client) SaveWebhookDelivery(ctx context.Context, delivery *companyauthpb.WebhookDelivery) (*companyauthpb.SaveWebhookDeliveryResponse, error) {
+	req := &companyauthpb.SaveWebhookDeliveryRequest{
+		Delivery: delivery,
+	}
+
+	return c.client.SaveWebhookDelivery(ctx, req)
+}
+
+func (c *Client) GetWebhookDelivery(ctx context.Context, id string, companyID int32) (*companyauthpb.GetWebhookDeliveryResponse, error) {
+	req := &companyauthpb.GetWebhookDeliveryRequest{
+		Id:        id,
+		CompanyId: companyID,
+	}
+
+	return c.client.GetWebhookDelivery(ctx, req)
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, req *companyauthpb.ListWebhookDeliveriesRequest) (*companyauthpb.ListWebhookDeliveriesResponse, error) {
+	return c.client.ListWebhookDeliveries(ctx, req)
+}
@@ -2,8 +2,12 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
 	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
 	baseClient "go-code-runner-microservice/api-gateway/internal/service/grpc"
 	"google.golang.org/grpc"
 )
@@ -41,12 +45,49 @@ func (c *Client) Close() error {
 	return c.base.Close()
 }
 
-func (c *Client) Execute(ctx context.Context, language, code string, problemID int) (*executorpb.ExecuteResponse, error) {
+// ExecuteOptions selects the test cases an execution runs against.
+type ExecuteOptions struct {
+	ProblemID int
+	// ProblemVersion pins the test cases to a version of the problem; zero
+	// uses the current version.
+	ProblemVersion int
+	// Harness runs the code as the problem's function for the language,
+	// wrapped with the problem's harness, rather than as a whole program.
+	Harness bool
+	// Owner is stored with the job, so that every gateway replica can check
+	// who may read or cancel it. Jobs without one are only visible to the
+	// gateway itself.
+	Owner jobs.Owner
+}
+
+func (o ExecuteOptions) request(language, code string) *executorpb.ExecuteRequest {
 	req := &executorpb.ExecuteRequest{
-		Language:  language,
-		Code:      code,
-		ProblemId: int32(problemID),
+		Language:       language,
+		Code:           code,
+		ProblemId:      int32(o.ProblemID),
+		ProblemVersion: int32(o.ProblemVersion),
+	}
+	if o.Harness {
+		req.Mode = executorpb.ExecutionMode_EXECUTION_MODE_HARNESS
 	}
+	if o.Owner.Key != "" {
+		req.Owner = &executorpb.JobOwner{
+			Key:       o.Owner.Key,
+			CompanyId: int32(o.Owner.CompanyID),
+		}
+	}
+	return req
+}
+
+func (c *Client) Execute(ctx context.Context, language, code string, opts ExecuteOptions) (*executorpb.ExecuteResponse, error) {
+	return c.client.Execute(ctx, opts.request(language, code))
+}
+
+// Run starts a scratch run of code on input. The job's RunResult holds the
+// outcome; nothing is graded. opts.ProblemID only matters with opts.Harness.
+func (c *Client) Run(ctx context.Context, language, code string, input *executorpb.RunInput, opts ExecuteOptions) (*executorpb.ExecuteResponse, error) {
+	req := opts.request(language, code)
+	req.Run = input
 
 	return c.client.Execute(ctx, req)
 }
@@ -58,3 +99,117 @@ func (c *Client) GetJobStatus(ctx context.Context, jobID string) (*executorpb.Ge
 
 	return c.client.GetJobStatus(ctx, req)
 }
+
+func (c *Client) CancelJob(ctx context.Context, jobID string) (*executorpb.CancelJobResponse, error) {
+	req := &executorpb.CancelJobRequest{
+		JobId: jobID,
+	}
+
+	return c.client.CancelJob(ctx, req)
+}
+
+// ExecuteTestCases runs code against the given cases instead of a problem's
+// stored test cases. Result test case IDs are the 1-based case positions.
+func (c *Client) ExecuteTestCases(ctx context.Context, language, code string, testCases []*executorpb.InlineTestCase) (*executorpb.ExecuteResponse, error) {
+	req := &executorpb.ExecuteRequest{
+		Language:  language,
+		Code:      code,
+		TestCases: testCases,
+	}
+
+	return c.client.Execute(ctx, req)
+}
+
+// WaitForJob polls a job until it reaches a terminal status or ctx is done,
+// backing off between polls.
+func (c *Client) WaitForJob(ctx context.Context, jobID string) (*executorpb.GetJobStatusResponse, error) {
+	return c.pollJob(ctx, jobID, false)
+}
+
+// AwaitJob is like WaitForJob, except that when ctx is done first it returns
+// the last status seen rather than an error.
+func (c *Client) AwaitJob(ctx context.Context, jobID string) (*executorpb.GetJobStatusResponse, error) {
+	return c.pollJob(ctx, jobID, true)
+}
+
+// Grade runs code against a problem's test cases, waits for the job and
+// returns its Score under weights. Code the executor judged without running
+// every case, e.g. because it does not compile, scores zero; an error means
+// the code could not be judged at all.
+func (c *Client) Grade(ctx context.Context, language, code string, opts ExecuteOptions, weights map[int32]int32) (int, error) {
+	resp, err := c.Execute(ctx, language, code, opts)
+	if err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, errors.New(resp.Error)
+	}
+
+	job, err := c.WaitForJob(ctx, resp.JobId)
+	if err != nil {
+		return 0, err
+	}
+	if !job.Success {
+		return 0, errors.New(job.Error)
+	}
+	if job.Status == JobStatusFailed && job.Verdict != executorpb.Verdict_VERDICT_UNSPECIFIED {
+		return 0, nil
+	}
+	if job.Status != JobStatusCompleted {
+		return 0, fmt.Errorf("job %s ended with status %s", resp.JobId, job.Status)
+	}
+	return Score(job.TestResults, weights), nil
+}
+
+// Score returns the weighted percentage of test cases passed. weights maps
+// test case IDs to their weight: cases of weight zero are run but not
+// scored, and cases without an entry weigh one. The score is zero when no
+// case is scored.
+func Score(results []*executorpb.TestResult, weights map[int32]int32) int {
+	var passed, total int
+	for _, tr := range results {
+		weight, ok := weights[tr.TestCaseId]
+		if !ok {
+			weight = 1
+		}
+		total += int(weight)
+		if tr.Passed {
+			passed += int(weight)
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return passed * 100 / total
+}
+
+func (c *Client) pollJob(ctx context.Context, jobID string, keepLast bool) (*executorpb.GetJobStatusResponse, error) {
+	var last *executorpb.GetJobStatusResponse
+	delay := initialPollDelay
+	for {
+		resp, err := c.GetJobStatus(ctx, jobID)
+		if err != nil {
+			if keepLast && last != nil && ctx.Err() != nil {
+				return last, nil
+			}
+			return nil, err
+		}
+		if !resp.Success || IsTerminal(resp.Status) {
+			return resp, nil
+		}
+		last = resp
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if keepLast {
+				return last, nil
+			}
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		delay = min(delay*2, maxPollDelay)
+	}
+}
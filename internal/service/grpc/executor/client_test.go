@@ -0,0 +1,43 @@
+package executor
+
+import (
+	"testing"
+
+	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+)
+
+func TestScore(t *testing.T) {
+	results := func(passed ...bool) []*executorpb.TestResult {
+		out := make([]*executorpb.TestResult, len(passed))
+		for i, p := range passed {
+			out[i] = &executorpb.TestResult{TestCaseId: int32(i + 1), Passed: p}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		results []*executorpb.TestResult
+		weights map[int32]int32
+		want    int
+	}{
+		{name: "no results", want: 0},
+		{name: "all passed", results: results(true, true), want: 100},
+		{name: "none passed", results: results(false, false), want: 0},
+		{name: "unweighted", results: results(true, false, false), want: 33},
+		{name: "missing weights weigh one", results: results(true, false), weights: map[int32]int32{}, want: 50},
+		{name: "weighted", results: results(true, false), weights: map[int32]int32{1: 3, 2: 1}, want: 75},
+		{name: "heavy case failed", results: results(true, false), weights: map[int32]int32{1: 1, 2: 9}, want: 10},
+		{name: "weight zero is not scored", results: results(true, false), weights: map[int32]int32{2: 0}, want: 100},
+		{name: "only unscored cases", results: results(true, true), weights: map[int32]int32{1: 0, 2: 0}, want: 0},
+		{name: "weights of other cases are ignored", results: results(true), weights: map[int32]int32{7: 5}, want: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Score(tt.results, tt.weights); got != tt.want {
+				t.Errorf("Score() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
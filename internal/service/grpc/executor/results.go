@@ -0,0 +1,116 @@
+package executor
+
+import (
+	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+	"go-code-runner-microservice/api-gateway/internal/jobs"
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+var verdictCodes = map[executorpb.Verdict]string{
+	executorpb.Verdict_VERDICT_ACCEPTED:              model.VerdictAccepted,
+	executorpb.Verdict_VERDICT_WRONG_ANSWER:          model.VerdictWrongAnswer,
+	executorpb.Verdict_VERDICT_TIME_LIMIT_EXCEEDED:   model.VerdictTimeLimitExceeded,
+	executorpb.Verdict_VERDICT_MEMORY_LIMIT_EXCEEDED: model.VerdictMemoryLimitExceeded,
+	executorpb.Verdict_VERDICT_RUNTIME_ERROR:         model.VerdictRuntimeError,
+	executorpb.Verdict_VERDICT_COMPILE_ERROR:         model.VerdictCompileError,
+}
+
+// VerdictCode returns the short verdict code, e.g. "WA", or "" when the
+// verdict is unspecified.
+func VerdictCode(v executorpb.Verdict) string {
+	return verdictCodes[v]
+}
+
+// ToTestResult converts a test result. The input and expected output of
+// hidden test cases are left out.
+func ToTestResult(tr *executorpb.TestResult) model.TestResult {
+	out := model.TestResult{
+		TestCaseID:      int(tr.TestCaseId),
+		Input:           tr.Input,
+		ExpectedOutput:  tr.ExpectedOutput,
+		ActualOutput:    tr.ActualOutput,
+		Error:           tr.Error,
+		Passed:          tr.Passed,
+		Verdict:         VerdictCode(tr.Verdict),
+		WallTimeMs:      tr.WallTimeMs,
+		CPUTimeMs:       tr.CpuTimeMs,
+		MemoryKB:        tr.MemoryKb,
+		ExitCode:        int(tr.ExitCode),
+		Signal:          tr.Signal,
+		OutputTruncated: tr.OutputTruncated,
+		ErrorTruncated:  tr.ErrorTruncated,
+		Hidden:          tr.IsHidden,
+	}
+	if tr.IsHidden {
+		out.Input = ""
+		out.ExpectedOutput = ""
+	}
+	return out
+}
+
+func ToRunResult(r *executorpb.RunResult) *model.RunResult {
+	if r == nil {
+		return nil
+	}
+	return &model.RunResult{
+		Stdout:          r.Stdout,
+		Stderr:          r.Stderr,
+		Verdict:         VerdictCode(r.Verdict),
+		ExitCode:        int(r.ExitCode),
+		Signal:          r.Signal,
+		RuntimeMs:       r.RuntimeMs,
+		CPUTimeMs:       r.CpuTimeMs,
+		MemoryKB:        r.MemoryKb,
+		Passed:          r.Passed,
+		StdoutTruncated: r.StdoutTruncated,
+		StderrTruncated: r.StderrTruncated,
+	}
+}
+
+func ToCompileDiagnostics(diags []*executorpb.CompileDiagnostic) []model.CompileDiagnostic {
+	if len(diags) == 0 {
+		return nil
+	}
+	out := make([]model.CompileDiagnostic, len(diags))
+	for i, d := range diags {
+		out[i] = model.CompileDiagnostic{
+			File:    d.File,
+			Line:    int(d.Line),
+			Column:  int(d.Column),
+			Message: d.Message,
+		}
+	}
+	return out
+}
+
+// ToJobStatusResponse renders a job status with every result detail the
+// executor reported.
+func ToJobStatusResponse(resp *executorpb.GetJobStatusResponse) model.JobStatusResponse {
+	out := model.JobStatusResponse{
+		Success:            true,
+		JobID:              resp.JobId,
+		Status:             resp.Status,
+		Output:             resp.Output,
+		Error:              resp.Error,
+		Run:                ToRunResult(resp.RunResult),
+		Verdict:            VerdictCode(resp.Verdict),
+		CompileDiagnostics: ToCompileDiagnostics(resp.CompileDiagnostics),
+		OutputTruncated:    resp.OutputTruncated,
+	}
+	if len(resp.TestResults) > 0 {
+		out.TestResults = make([]model.TestResult, len(resp.TestResults))
+		for i, tr := range resp.TestResults {
+			out.TestResults[i] = ToTestResult(tr)
+		}
+	}
+	return out
+}
+
+// ToJobOwner returns the owner a job was submitted with. ok is false for
+// jobs submitted without one.
+func ToJobOwner(o *executorpb.JobOwner) (owner jobs.Owner, ok bool) {
+	if o == nil || o.Key == "" {
+		return jobs.Owner{}, false
+	}
+	return jobs.Owner{Key: o.Key, CompanyID: int(o.CompanyId)}, true
+}
@@ -0,0 +1,22 @@
+package executor
+
+import "time"
+
+// Job statuses reported by the executor service.
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusFailed    = "failed"
+	JobStatusCancelled = "cancelled"
+)
+
+const (
+	initialPollDelay = 100 * time.Millisecond
+	maxPollDelay     = 2 * time.Second
+)
+
+// IsTerminal reports whether a job in this status will not change any more.
+func IsTerminal(status string) bool {
+	return status == JobStatusCompleted || status == JobStatusFailed || status == JobStatusCancelled
+}
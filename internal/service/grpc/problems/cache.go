@@ -0,0 +1,248 @@
+package problems
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	problemspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/problems/v1"
+	"go-code-runner-microservice/api-gateway/internal/cache"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	listKey            = "list"
+	problemKeyPrefix   = "problem:"
+	testCasesKeyPrefix = "test_cases:"
+	versionKeyPrefix   = "version:"
+)
+
+// CachedClient is a read-through cache in front of a Service. Entries are
+// fresh for ttl; concurrent misses for the same key share one backend call,
+// and when the backend fails an expired entry younger than maxStale is served
+// instead of the error.
+type CachedClient struct {
+	next     Service
+	entries  *cache.LRU[string, any]
+	group    singleflight.Group
+	ttl      time.Duration
+	maxStale time.Duration
+}
+
+func NewCachedClient(next Service, ttl, maxStale time.Duration, maxEntries int) *CachedClient {
+	return &CachedClient{
+		next:     next,
+		entries:  cache.NewLRU[string, any](maxEntries),
+		ttl:      ttl,
+		maxStale: maxStale,
+	}
+}
+
+func (c *CachedClient) GetProblem(ctx context.Context, id int32) (*problemspb.GetProblemResponse, error) {
+	v, err := c.get(ctx, problemKeyPrefix+strconv.Itoa(int(id)), func(ctx context.Context) (any, error) {
+		return c.next.GetProblem(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*problemspb.GetProblemResponse), nil
+}
+
+func (c *CachedClient) ListProblems(ctx context.Context, opts ListOptions) (*problemspb.ListProblemsResponse, error) {
+	v, err := c.get(ctx, listCacheKey(opts), func(ctx context.Context) (any, error) {
+		return c.next.ListProblems(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*problemspb.ListProblemsResponse), nil
+}
+
+func (c *CachedClient) GetTestCasesByProblemID(ctx context.Context, problemID int32) (*problemspb.GetTestCasesByProblemIDResponse, error) {
+	v, err := c.get(ctx, testCasesKeyPrefix+strconv.Itoa(int(problemID)), func(ctx context.Context) (any, error) {
+		return c.next.GetTestCasesByProblemID(ctx, problemID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*problemspb.GetTestCasesByProblemIDResponse), nil
+}
+
+// GetProblemVersion caches pinned versions, which never change, for as long
+// as the LRU keeps them. The current version (zero) is not cached.
+func (c *CachedClient) GetProblemVersion(ctx context.Context, problemID, version int32) (*problemspb.GetProblemVersionResponse, error) {
+	if version == 0 {
+		return c.next.GetProblemVersion(ctx, problemID, version)
+	}
+
+	key := fmt.Sprintf("%s%d:%d", versionKeyPrefix, problemID, version)
+	if v, _, found := c.entries.Get(key); found {
+		return v.(*problemspb.GetProblemVersionResponse), nil
+	}
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		resp, err := c.next.GetProblemVersion(context.WithoutCancel(ctx), problemID, version)
+		if err != nil {
+			return nil, err
+		}
+		c.entries.Set(key, resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*problemspb.GetProblemVersionResponse), nil
+}
+
+// ListProblemVersions is not cached; it changes with every write.
+func (c *CachedClient) ListProblemVersions(ctx context.Context, problemID int32) (*problemspb.ListProblemVersionsResponse, error) {
+	return c.next.ListProblemVersions(ctx, problemID)
+}
+
+// Writes go straight to the backend and then purge whatever they may have
+// made stale. Test case writes also bump the problem's version.
+
+func (c *CachedClient) CreateProblem(ctx context.Context, companyID int32, in ProblemInput) (*problemspb.CreateProblemResponse, error) {
+	resp, err := c.next.CreateProblem(ctx, companyID, in)
+	if err == nil {
+		c.purgeLists()
+	}
+	return resp, err
+}
+
+func (c *CachedClient) UpdateProblem(ctx context.Context, id, companyID int32, in ProblemInput) (*problemspb.UpdateProblemResponse, error) {
+	resp, err := c.next.UpdateProblem(ctx, id, companyID, in)
+	if err == nil {
+		c.purgeProblem(id, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) DeleteProblem(ctx context.Context, id, companyID int32) (*problemspb.DeleteProblemResponse, error) {
+	resp, err := c.next.DeleteProblem(ctx, id, companyID)
+	if err == nil {
+		c.purgeProblem(id, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) CreateTestCase(ctx context.Context, problemID, companyID int32, in TestCaseInput) (*problemspb.CreateTestCaseResponse, error) {
+	resp, err := c.next.CreateTestCase(ctx, problemID, companyID, in)
+	if err == nil {
+		c.purgeProblem(problemID, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) UpdateTestCase(ctx context.Context, id, problemID, companyID int32, in TestCaseInput) (*problemspb.UpdateTestCaseResponse, error) {
+	resp, err := c.next.UpdateTestCase(ctx, id, problemID, companyID, in)
+	if err == nil {
+		c.purgeProblem(problemID, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) DeleteTestCase(ctx context.Context, id, problemID, companyID int32) (*problemspb.DeleteTestCaseResponse, error) {
+	resp, err := c.next.DeleteTestCase(ctx, id, problemID, companyID)
+	if err == nil {
+		c.purgeProblem(problemID, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) ReorderTestCases(ctx context.Context, problemID, companyID int32, testCaseIDs []int32) (*problemspb.ReorderTestCasesResponse, error) {
+	resp, err := c.next.ReorderTestCases(ctx, problemID, companyID, testCaseIDs)
+	if err == nil {
+		c.purgeProblem(problemID, false)
+	}
+	return resp, err
+}
+
+func (c *CachedClient) BulkCreateTestCases(ctx context.Context, problemID, companyID int32, in []TestCaseInput, replaceExisting bool) (*problemspb.BulkCreateTestCasesResponse, error) {
+	resp, err := c.next.BulkCreateTestCases(ctx, problemID, companyID, in, replaceExisting)
+	if err == nil {
+		c.purgeProblem(problemID, false)
+	}
+	return resp, err
+}
+
+// listCacheKey gives every distinct listing its own entry; all of them share
+// the listKey prefix so they can be purged together.
+func listCacheKey(opts ListOptions) string {
+	return fmt.Sprintf("%s:%d:%d:%s:%s:%s:%q:%s:%s",
+		listKey,
+		opts.ViewerCompanyID,
+		opts.PageSize,
+		opts.PageToken,
+		strings.Join(opts.Difficulties, ","),
+		strings.Join(opts.Tags, ","),
+		opts.Search,
+		opts.SortBy,
+		opts.SortOrder,
+	)
+}
+
+func (c *CachedClient) get(ctx context.Context, key string, load func(context.Context) (any, error)) (any, error) {
+	cached, storedAt, found := c.entries.Get(key)
+	age := time.Since(storedAt)
+	if found && age < c.ttl {
+		return cached, nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		// The first caller's context is shared by every waiter, so it must
+		// not be cancelled just because that one client went away.
+		v, err := load(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		c.entries.Set(key, v)
+		return v, nil
+	})
+	if err == nil {
+		return v, nil
+	}
+
+	if found && age < c.ttl+c.maxStale {
+		logger.WithContext(ctx).Warn("serving stale problems cache entry",
+			zap.String("key", key),
+			zap.Duration("age", age),
+			zap.Error(err),
+		)
+		return cached, nil
+	}
+	return nil, err
+}
+
+// PurgeProblem drops everything cached for a problem, including its pinned
+// versions and the listings that contain it, and returns the number of
+// entries removed.
+func (c *CachedClient) PurgeProblem(id int32) int {
+	return c.purgeProblem(id, true)
+}
+
+// purgeProblem is PurgeProblem for writes, which never change pinned
+// versions, so those are only dropped when withVersions is set.
+func (c *CachedClient) purgeProblem(id int32, withVersions bool) int {
+	idStr := strconv.Itoa(int(id))
+	return c.entries.DeleteFunc(func(key string) bool {
+		if withVersions && strings.HasPrefix(key, versionKeyPrefix+idStr+":") {
+			return true
+		}
+		return key == problemKeyPrefix+idStr || key == testCasesKeyPrefix+idStr || strings.HasPrefix(key, listKey)
+	})
+}
+
+func (c *CachedClient) purgeLists() int {
+	return c.entries.DeleteFunc(func(key string) bool {
+		return strings.HasPrefix(key, listKey)
+	})
+}
+
+// PurgeAll empties the cache.
+func (c *CachedClient) PurgeAll() int {
+	return c.entries.DeleteFunc(func(string) bool { return true })
+}
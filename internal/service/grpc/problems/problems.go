@@ -8,6 +8,75 @@ import (
 	"google.golang.org/grpc"
 )
 
+// Service is the set of problem lookups used by the HTTP handlers. It is
+// implemented by Client and by CachedClient.
+type Service interface {
+	GetProblem(ctx context.Context, id int32) (*problemspb.GetProblemResponse, error)
+	ListProblems(ctx context.Context, opts ListOptions) (*problemspb.ListProblemsResponse, error)
+	GetTestCasesByProblemID(ctx context.Context, problemID int32) (*problemspb.GetTestCasesByProblemIDResponse, error)
+	CreateProblem(ctx context.Context, companyID int32, in ProblemInput) (*problemspb.CreateProblemResponse, error)
+	UpdateProblem(ctx context.Context, id, companyID int32, in ProblemInput) (*problemspb.UpdateProblemResponse, error)
+	DeleteProblem(ctx context.Context, id, companyID int32) (*problemspb.DeleteProblemResponse, error)
+	CreateTestCase(ctx context.Context, problemID, companyID int32, in TestCaseInput) (*problemspb.CreateTestCaseResponse, error)
+	UpdateTestCase(ctx context.Context, id, problemID, companyID int32, in TestCaseInput) (*problemspb.UpdateTestCaseResponse, error)
+	DeleteTestCase(ctx context.Context, id, problemID, companyID int32) (*problemspb.DeleteTestCaseResponse, error)
+	ReorderTestCases(ctx context.Context, problemID, companyID int32, testCaseIDs []int32) (*problemspb.ReorderTestCasesResponse, error)
+	BulkCreateTestCases(ctx context.Context, problemID, companyID int32, in []TestCaseInput, replaceExisting bool) (*problemspb.BulkCreateTestCasesResponse, error)
+	GetProblemVersion(ctx context.Context, problemID, version int32) (*problemspb.GetProblemVersionResponse, error)
+	ListProblemVersions(ctx context.Context, problemID int32) (*problemspb.ListProblemVersionsResponse, error)
+}
+
+// ListOptions controls paging, filtering and ordering of ListProblems.
+type ListOptions struct {
+	PageSize     int32
+	PageToken    string
+	Difficulties []string
+	Tags         []string
+	Search       string
+	SortBy       string
+	SortOrder    string
+	// ViewerCompanyID additionally includes that company's private problems.
+	ViewerCompanyID int32
+}
+
+// ProblemInput holds the editable fields of a company-authored problem.
+type ProblemInput struct {
+	Title         string
+	Description   string
+	Difficulty    string
+	Tags          []string
+	TimeLimitMs   int32
+	MemoryLimitKB int32
+	Visibility    string
+	// Files replaces the stored reference solutions and starter code. Nil
+	// leaves them unchanged on update.
+	Files *ProblemFiles
+}
+
+// ProblemFiles are the source files stored alongside a problem.
+type ProblemFiles struct {
+	ReferenceSolutions []*problemspb.SourceFile
+	StarterCode        []*problemspb.SourceFile
+	Harnesses          []*problemspb.Harness
+}
+
+// TestCaseInput holds the editable fields of a test case.
+type TestCaseInput struct {
+	Input          string
+	ExpectedOutput string
+	IsHidden       bool
+	Weight         int32
+}
+
+func (in TestCaseInput) toProto() *problemspb.TestCaseInput {
+	return &problemspb.TestCaseInput{
+		Input:          in.Input,
+		ExpectedOutput: in.ExpectedOutput,
+		IsHidden:       in.IsHidden,
+		Weight:         in.Weight,
+	}
+}
+
 type Client struct {
 	client problemspb.ProblemServiceClient
 	base   *baseClient.Client
@@ -49,8 +118,17 @@ func (c *Client) GetProblem(ctx context.Context, id int32) (*problemspb.GetProbl
 	return c.client.GetProblem(ctx, req)
 }
 
-func (c *Client) ListProblems(ctx context.Context) (*problemspb.ListProblemsResponse, error) {
-	req := &problemspb.ListProblemsRequest{}
+func (c *Client) ListProblems(ctx context.Context, opts ListOptions) (*problemspb.ListProblemsResponse, error) {
+	req := &problemspb.ListProblemsRequest{
+		PageSize:        opts.PageSize,
+		PageToken:       opts.PageToken,
+		Difficulties:    opts.Difficulties,
+		Tags:            opts.Tags,
+		Search:          opts.Search,
+		SortBy:          opts.SortBy,
+		SortOrder:       opts.SortOrder,
+		ViewerCompanyId: opts.ViewerCompanyID,
+	}
 
 	return c.client.ListProblems(ctx, req)
 }
@@ -62,3 +140,142 @@ func (c *Client) GetTestCasesByProblemID(ctx context.Context, problemID int32) (
 
 	return c.client.GetTestCasesByProblemID(ctx, req)
 }
+
+func (c *Client) CreateProblem(ctx context.Context, companyID int32, in ProblemInput) (*problemspb.CreateProblemResponse, error) {
+	req := &problemspb.CreateProblemRequest{
+		CompanyId:     companyID,
+		Title:         in.Title,
+		Description:   in.Description,
+		Difficulty:    in.Difficulty,
+		Tags:          in.Tags,
+		TimeLimitMs:   in.TimeLimitMs,
+		MemoryLimitKb: in.MemoryLimitKB,
+		Visibility:    in.Visibility,
+	}
+	if in.Files != nil {
+		req.ReferenceSolutions = in.Files.ReferenceSolutions
+		req.StarterCode = in.Files.StarterCode
+		req.Harnesses = in.Files.Harnesses
+	}
+
+	return c.client.CreateProblem(ctx, req)
+}
+
+func (c *Client) UpdateProblem(ctx context.Context, id, companyID int32, in ProblemInput) (*problemspb.UpdateProblemResponse, error) {
+	req := &problemspb.UpdateProblemRequest{
+		Id:            id,
+		CompanyId:     companyID,
+		Title:         in.Title,
+		Description:   in.Description,
+		Difficulty:    in.Difficulty,
+		Tags:          in.Tags,
+		TimeLimitMs:   in.TimeLimitMs,
+		MemoryLimitKb: in.MemoryLimitKB,
+		Visibility:    in.Visibility,
+	}
+	if in.Files != nil {
+		req.ReplaceFiles = true
+		req.ReferenceSolutions = in.Files.ReferenceSolutions
+		req.StarterCode = in.Files.StarterCode
+		req.Harnesses = in.Files.Harnesses
+	}
+
+	return c.client.UpdateProblem(ctx, req)
+}
+
+func (c *Client) DeleteProblem(ctx context.Context, id, companyID int32) (*problemspb.DeleteProblemResponse, error) {
+	req := &problemspb.DeleteProblemRequest{
+		Id:        id,
+		CompanyId: companyID,
+	}
+
+	return c.client.DeleteProblem(ctx, req)
+}
+
+func (c *Client) CreateTestCase(ctx context.Context, problemID, companyID int32, in TestCaseInput) (*problemspb.CreateTestCaseResponse, error) {
+	req := &problemspb.CreateTestCaseRequest{
+		ProblemId: problemID,
+		CompanyId: companyID,
+		TestCase:  in.toProto(),
+	}
+
+	return c.client.CreateTestCase(ctx, req)
+}
+
+func (c *Client) UpdateTestCase(ctx context.Context, id, problemID, companyID int32, in TestCaseInput) (*problemspb.UpdateTestCaseResponse, error) {
+	req := &problemspb.UpdateTestCaseRequest{
+		Id:        id,
+		ProblemId: problemID,
+		CompanyId: companyID,
+		TestCase:  in.toProto(),
+	}
+
+	return c.client.UpdateTestCase(ctx, req)
+}
+
+func (c *Client) DeleteTestCase(ctx context.Context, id, problemID, companyID int32) (*problemspb.DeleteTestCaseResponse, error) {
+	req := &problemspb.DeleteTestCaseRequest{
+		Id:        id,
+		ProblemId: problemID,
+		CompanyId: companyID,
+	}
+
+	return c.client.DeleteTestCase(ctx, req)
+}
+
+func (c *Client) ReorderTestCases(ctx context.Context, problemID, companyID int32, testCaseIDs []int32) (*problemspb.ReorderTestCasesResponse, error) {
+	req := &problemspb.ReorderTestCasesRequest{
+		ProblemId:   problemID,
+		CompanyId:   companyID,
+		TestCaseIds: testCaseIDs,
+	}
+
+	return c.client.ReorderTestCases(ctx, req)
+}
+
+func (c *Client) BulkCreateTestCases(ctx context.Context, problemID, companyID int32, in []TestCaseInput, replaceExisting bool) (*problemspb.BulkCreateTestCasesResponse, error) {
+	testCases := make([]*problemspb.TestCaseInput, len(in))
+	for i, tc := range in {
+		testCases[i] = tc.toProto()
+	}
+
+	req := &problemspb.BulkCreateTestCasesRequest{
+		ProblemId:       problemID,
+		CompanyId:       companyID,
+		TestCases:       testCases,
+		ReplaceExisting: replaceExisting,
+	}
+
+	return c.client.BulkCreateTestCases(ctx, req)
+}
+
+func (c *Client) GetProblemVersion(ctx context.Context, problemID, version int32) (*problemspb.GetProblemVersionResponse, error) {
+	req := &problemspb.GetProblemVersionRequest{
+		ProblemId: problemID,
+		Version:   version,
+	}
+
+	return c.client.GetProblemVersion(ctx, req)
+}
+
+func (c *Client) ListProblemVersions(ctx context.Context, problemID int32) (*problemspb.ListProblemVersionsResponse, error) {
+	req := &problemspb.ListProblemVersionsRequest{
+		ProblemId: problemID,
+	}
+
+	return c.client.ListProblemVersions(ctx, req)
+}
+
+// TestCaseWeights returns the weights of the test cases of a problem version,
+// keyed by test case ID. Version zero is the current version.
+func TestCaseWeights(ctx context.Context, svc Service, problemID, version int32) (map[int32]int32, error) {
+	resp, err := svc.GetProblemVersion(ctx, problemID, version)
+	if err != nil {
+		return nil, err
+	}
+	weights := make(map[int32]int32, len(resp.Version.TestCases))
+	for _, tc := range resp.Version.TestCases {
+		weights[tc.Id] = tc.Weight
+	}
+	return weights, nil
+}
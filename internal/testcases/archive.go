@@ -0,0 +1,163 @@
+package testcases
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go-code-runner-microservice/api-gateway/internal/model"
+)
+
+const (
+	inputExt  = ".in"
+	outputExt = ".out"
+)
+
+var errArchiveTooLarge = errors.New("archive exceeds the uncompressed size limit")
+
+// Case is an input/expected output pair read from an archive.
+type Case struct {
+	Name           string
+	Input          string
+	ExpectedOutput string
+}
+
+// ParseZip reads test cases from a zip archive of NAME.in / NAME.out pairs.
+// Cases are ordered by name, numerically when both names are numbers, so
+// "2" sorts before "10". maxBytes caps the total uncompressed size.
+//
+// Every problem found is reported as a FileError; cases are only returned
+// when there are none.
+func ParseZip(data []byte, maxBytes int64) ([]Case, []model.FileError) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, []model.FileError{{Message: "Invalid zip archive: " + err.Error()}}
+	}
+
+	files := make(map[string]string)
+	var errs []model.FileError
+	remaining := maxBytes
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || IgnoredFile(f.Name) {
+			continue
+		}
+
+		content, err := readFile(f, remaining)
+		if errors.Is(err, errArchiveTooLarge) {
+			return nil, []model.FileError{{File: f.Name, Message: err.Error()}}
+		}
+		if err != nil {
+			errs = append(errs, model.FileError{File: f.Name, Message: err.Error()})
+			continue
+		}
+		remaining -= int64(len(content))
+		files[f.Name] = content
+	}
+
+	cases, pairErrs := Pair(files)
+	errs = append(errs, pairErrs...)
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].File < errs[j].File })
+		return nil, errs
+	}
+	if len(cases) == 0 {
+		return nil, []model.FileError{{Message: "Archive contains no test cases"}}
+	}
+	return cases, nil
+}
+
+// Pair matches NAME.in and NAME.out files, keyed by path, into cases ordered
+// by name. Files without a partner or with another extension are reported.
+func Pair(files map[string]string) ([]Case, []model.FileError) {
+	inputs := make(map[string]string)
+	outputs := make(map[string]string)
+	var errs []model.FileError
+
+	for name, content := range files {
+		switch ext := path.Ext(name); ext {
+		case inputExt:
+			inputs[strings.TrimSuffix(name, ext)] = content
+		case outputExt:
+			outputs[strings.TrimSuffix(name, ext)] = content
+		default:
+			errs = append(errs, model.FileError{File: name, Message: "expected a .in or .out file"})
+		}
+	}
+
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		if _, ok := outputs[name]; !ok {
+			errs = append(errs, model.FileError{File: name + inputExt, Message: "missing matching " + outputExt + " file"})
+			continue
+		}
+		names = append(names, name)
+	}
+	for name := range outputs {
+		if _, ok := inputs[name]; !ok {
+			errs = append(errs, model.FileError{File: name + outputExt, Message: "missing matching " + inputExt + " file"})
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].File < errs[j].File })
+
+	sort.Slice(names, func(i, j int) bool { return lessName(names[i], names[j]) })
+
+	cases := make([]Case, len(names))
+	for i, name := range names {
+		cases[i] = Case{
+			Name:           name,
+			Input:          inputs[name],
+			ExpectedOutput: outputs[name],
+		}
+	}
+	return cases, errs
+}
+
+func readFile(f *zip.File, remaining int64) (string, error) {
+	if remaining <= 0 || f.UncompressedSize64 > uint64(remaining) {
+		return "", errArchiveTooLarge
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open: %w", err)
+	}
+	defer rc.Close()
+
+	// The size in the header is not trusted.
+	content, err := io.ReadAll(io.LimitReader(rc, remaining+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read: %w", err)
+	}
+	if int64(len(content)) > remaining {
+		return "", errArchiveTooLarge
+	}
+	if !utf8.Valid(content) {
+		return "", fmt.Errorf("file must be valid UTF-8")
+	}
+	return string(content), nil
+}
+
+// IgnoredFile reports metadata that archivers and editors add on their own.
+func IgnoredFile(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), ".")
+}
+
+func lessName(a, b string) bool {
+	an, aErr := strconv.Atoi(path.Base(a))
+	bn, bErr := strconv.Atoi(path.Base(b))
+	if aErr == nil && bErr == nil && path.Dir(a) == path.Dir(b) && an != bn {
+		return an < bn
+	}
+	return a < b
+}
@@ -0,0 +1,100 @@
+package testcases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	executorpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/executor/v1"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+)
+
+// ErrVerificationTimeout is returned when the reference solution does not
+// finish within the verifier's timeout.
+var ErrVerificationTimeout = errors.New("reference solution did not finish in time")
+
+// Verifier checks test cases by running a reference solution against them
+// through the executor.
+type Verifier struct {
+	executor *executor.Client
+	timeout  time.Duration
+}
+
+func NewVerifier(executorClient *executor.Client, timeout time.Duration) *Verifier {
+	return &Verifier{
+		executor: executorClient,
+		timeout:  timeout,
+	}
+}
+
+// Verify runs solution against cases and returns the results of the cases it
+// did not reproduce. A result's TestCaseID is the 1-based position of the case
+// in cases. An error means the solution could not be run at all.
+func (v *Verifier) Verify(ctx context.Context, solution model.ReferenceSolution, cases []problems.TestCaseInput) ([]model.TestResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	inline := make([]*executorpb.InlineTestCase, len(cases))
+	for i, tc := range cases {
+		inline[i] = &executorpb.InlineTestCase{
+			Input:          tc.Input,
+			ExpectedOutput: tc.ExpectedOutput,
+		}
+	}
+
+	resp, err := v.executor.ExecuteTestCases(ctx, solution.Language, solution.Code, inline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run reference solution: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to run reference solution: %s", resp.Error)
+	}
+
+	job, err := v.executor.WaitForJob(ctx, resp.JobId)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrVerificationTimeout
+		}
+		return nil, fmt.Errorf("failed to get reference solution result: %w", err)
+	}
+	if !job.Success {
+		return nil, fmt.Errorf("failed to get reference solution result: %s", job.Error)
+	}
+
+	results := make(map[int]*executorpb.TestResult, len(job.TestResults))
+	for _, tr := range job.TestResults {
+		results[int(tr.TestCaseId)] = tr
+	}
+
+	var failures []model.TestResult
+	for i, tc := range cases {
+		tr, ok := results[i+1]
+		if !ok {
+			// A job that failed before running every case, e.g. because the
+			// solution does not compile, reports why in its own error.
+			msg := job.Error
+			if msg == "" {
+				msg = "no result for test case"
+			}
+			failures = append(failures, model.TestResult{
+				TestCaseID:     i + 1,
+				Input:          tc.Input,
+				ExpectedOutput: tc.ExpectedOutput,
+				Error:          msg,
+				Verdict:        executor.VerdictCode(job.Verdict),
+			})
+			continue
+		}
+		if !tr.Passed {
+			failure := executor.ToTestResult(tr)
+			failure.TestCaseID = i + 1
+			failure.Input = tc.Input
+			failure.ExpectedOutput = tc.ExpectedOutput
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
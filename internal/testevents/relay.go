@@ -0,0 +1,153 @@
+// Package testevents relays coding test lifecycle events from the coding
+// tests service to the webhook subscriptions of the company that owns the
+// test.
+//
+// Every gateway replica runs a relay under the same consumer name. The
+// service lets only one of them stream at a time and keeps the position it
+// acknowledged, so each event is relayed once and none are skipped across
+// restarts; the others stand by and take over when the stream is free.
+package testevents
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
+	"go-code-runner-microservice/api-gateway/internal/webhook"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+type Relay struct {
+	codingTests *coding_tests.Client
+	companyAuth *company_auth.Client
+	dispatcher  *webhook.Dispatcher
+	consumer    string
+}
+
+func NewRelay(codingTestsClient *coding_tests.Client, companyAuthClient *company_auth.Client, dispatcher *webhook.Dispatcher, consumer string) *Relay {
+	return &Relay{
+		codingTests: codingTestsClient,
+		companyAuth: companyAuthClient,
+		dispatcher:  dispatcher,
+		consumer:    consumer,
+	}
+}
+
+// Run consumes the event stream until ctx is cancelled. After an error it
+// reconnects with backoff and resumes after the last event it acknowledged,
+// so an event whose subscriptions could not be loaded is retried rather than
+// lost. While another replica holds the stream it retries at the longest
+// backoff.
+func (r *Relay) Run(ctx context.Context) {
+	log := logger.Get()
+
+	backoff := minBackoff
+	for {
+		relayed, err := r.consume(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if relayed > 0 {
+			backoff = minBackoff
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			log.Debug("test events are relayed by another instance",
+				zap.String("consumer", r.consumer),
+			)
+			backoff = maxBackoff
+		} else {
+			log.Warn("test event stream interrupted",
+				zap.String("consumer", r.consumer),
+				zap.Duration("retry_in", backoff),
+				zap.Error(err),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// consume relays events from one stream, acknowledging each once its
+// deliveries are queued, and returns the number relayed when the stream or a
+// relay fails.
+func (r *Relay) consume(ctx context.Context) (int, error) {
+	stream, err := r.codingTests.WatchTestEvents(ctx, r.consumer)
+	if err != nil {
+		return 0, err
+	}
+
+	relayed := 0
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return relayed, err
+		}
+		if err := r.relay(ctx, event); err != nil {
+			return relayed, err
+		}
+		if _, err := r.codingTests.AckTestEvent(ctx, r.consumer, event.Id); err != nil {
+			return relayed, fmt.Errorf("failed to acknowledge event %s: %w", event.Id, err)
+		}
+		relayed++
+	}
+}
+
+func (r *Relay) relay(ctx context.Context, event *codingtestspb.TestEvent) error {
+	if event.Test == nil {
+		return nil
+	}
+
+	resp, err := r.companyAuth.ListWebhookSubscriptions(ctx, event.Test.CompanyId)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to list webhook subscriptions: %s", resp.GetError())
+	}
+
+	payload := model.TestEventPayload{
+		EventID: event.Id,
+		Test:    coding_tests.ToCodingTest(event.Test),
+	}
+	if event.OccurredAt != nil {
+		payload.OccurredAt = event.OccurredAt.AsTime()
+	}
+
+	for _, sub := range resp.Subscriptions {
+		if !sub.Active || !slices.Contains(sub.Events, event.Type) {
+			continue
+		}
+		msg := webhook.Message{
+			CompanyID: int(sub.CompanyId),
+			WebhookID: int(sub.Id),
+			URL:       sub.Url,
+			Event:     event.Type,
+			TestID:    event.Test.Id,
+			Data:      payload,
+		}
+		// The event is only acknowledged once every delivery is queued;
+		// receivers deduplicate repeats on the event ID.
+		if _, err := r.dispatcher.Send(ctx, msg, []byte(sub.Secret)); err != nil {
+			return fmt.Errorf("failed to queue delivery to webhook %d: %w", sub.Id, err)
+		}
+	}
+	return nil
+}
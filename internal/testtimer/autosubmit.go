@@ -0,0 +1,176 @@
+package testtimer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go-code-runner-microservice/api-gateway/internal/model"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/coding_tests"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/executor"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/problems"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AutoSubmitter grades the candidate's latest draft against the test's
+// problem and submits it with the resulting score.
+type AutoSubmitter struct {
+	codingTests *coding_tests.Client
+	executor    *executor.Client
+	problems    problems.Service
+	timeout     time.Duration
+}
+
+func NewAutoSubmitter(codingTestsClient *coding_tests.Client, executorClient *executor.Client, problemsClient problems.Service, timeout time.Duration) *AutoSubmitter {
+	return &AutoSubmitter{
+		codingTests: codingTestsClient,
+		executor:    executorClient,
+		problems:    problemsClient,
+		timeout:     timeout,
+	}
+}
+
+// Submit is a SubmitFunc. Whichever of the latest saved draft and last is
+// newer is submitted. A test that was submitted in the meantime is left
+// alone, here or by the service if it happens during grading. Code that
+// fails its test cases, or does not compile, scores zero, but code the
+// executor could not judge at all is not submitted: the error is returned so
+// that the scheduler tries again.
+func (a *AutoSubmitter) Submit(testID string, last *Snapshot) error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+	log := logger.Get().With(zap.String("test_id", testID))
+
+	resp, err := a.codingTests.VerifyTest(ctx, testID)
+	if err != nil {
+		return fmt.Errorf("failed to load test: %w", err)
+	}
+	if resp.Test.CompletedAt != nil {
+		return nil
+	}
+	if coding_tests.IsAssessment(resp.Test) {
+		return a.submitAssessment(ctx, resp.Test, last)
+	}
+
+	last = newer(a.latestProblemDraft(ctx, testID, 0), last)
+
+	code, passed := "", 0
+	if last != nil {
+		code = last.Code
+		passed, err = a.grade(ctx, coding_tests.Problems(resp.Test)[0], last)
+		if err != nil {
+			return fmt.Errorf("failed to grade code: %w", err)
+		}
+	}
+
+	if _, err := a.codingTests.AutoSubmitTest(ctx, testID, code, int32(passed)); err != nil {
+		return submitError(log, "failed to auto-submit test", err)
+	}
+	log.Info("auto-submitted test", zap.Int("passed_percentage", passed))
+	return nil
+}
+
+// submitAssessment submits a multi-problem assessment. Every problem whose
+// latest code, from its drafts or last, is newer than its last submission,
+// or that was never submitted, is graded and submitted first; the test is
+// then submitted with the weighted total of the problem scores. When a
+// problem cannot be graded or submitted the test is left open, and the
+// problems submitted so far are skipped on the next attempt.
+func (a *AutoSubmitter) submitAssessment(ctx context.Context, test *codingtestspb.CodingTest, last *Snapshot) error {
+	log := logger.Get().With(zap.String("test_id", test.Id))
+
+	submitted := make(map[int32]*codingtestspb.ProblemSubmission, len(test.Submissions))
+	for _, s := range test.Submissions {
+		submitted[s.ProblemId] = s
+	}
+
+	submissions := test.Submissions
+	for _, p := range coding_tests.Problems(test) {
+		draft := a.latestProblemDraft(ctx, test.Id, p.ProblemId)
+		if last != nil && last.ProblemID == p.ProblemId {
+			draft = newer(draft, last)
+		}
+		prev := submitted[p.ProblemId]
+		if draft == nil || (prev != nil && prev.SubmittedAt != nil && !draft.SavedAt.After(prev.SubmittedAt.AsTime())) {
+			continue
+		}
+
+		passed, err := a.grade(ctx, p, draft)
+		if err != nil {
+			return fmt.Errorf("failed to grade problem %d: %w", p.ProblemId, err)
+		}
+		resp, err := a.codingTests.SubmitProblem(ctx, test.Id, p.ProblemId, draft.Language, draft.Code, int32(passed))
+		if err != nil {
+			return submitError(log, fmt.Sprintf("failed to auto-submit problem %d", p.ProblemId), err)
+		}
+		submissions = coding_tests.WithSubmission(submissions, resp.Submission)
+	}
+
+	total := coding_tests.WeightedScore(test, submissions)
+	if _, err := a.codingTests.AutoSubmitTest(ctx, test.Id, "", int32(total)); err != nil {
+		return submitError(log, "failed to auto-submit test", err)
+	}
+	log.Info("auto-submitted assessment", zap.Int("total_score", total))
+	return nil
+}
+
+// submitError wraps a failed submission call with msg. The service rejects
+// tests the candidate submitted while they were being graded, which is not
+// an error.
+func submitError(log *zap.Logger, msg string, err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		log.Info("test was submitted before auto-submission", zap.Error(err))
+		return nil
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// latestProblemDraft returns the newest draft saved for a problem of a
+// multi-problem assessment, or nil if there is none or it cannot be loaded.
+// problemID zero loads the draft of a single-problem test.
+func (a *AutoSubmitter) latestProblemDraft(ctx context.Context, testID string, problemID int32) *Snapshot {
+	resp, err := a.codingTests.GetDraft(ctx, testID, problemID, 0)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			logger.Get().Warn("failed to load draft for auto-submission",
+				zap.String("test_id", testID),
+				zap.Int32("problem_id", problemID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+	return &Snapshot{
+		ProblemID: problemID,
+		Language:  resp.Draft.Language,
+		Code:      resp.Draft.Code,
+		Harness:   resp.Draft.Mode == model.ExecutionModeHarness,
+		SavedAt:   resp.Draft.SavedAt.AsTime(),
+	}
+}
+
+// newer returns whichever of a and b was saved last, ignoring nil ones.
+func newer(a, b *Snapshot) *Snapshot {
+	if a == nil || (b != nil && b.SavedAt.After(a.SavedAt)) {
+		return b
+	}
+	return a
+}
+
+// grade runs code against the pinned version of a test's problem and returns
+// the weighted percentage of test cases passed.
+func (a *AutoSubmitter) grade(ctx context.Context, problem *codingtestspb.AssessmentProblem, last *Snapshot) (int, error) {
+	weights, err := problems.TestCaseWeights(ctx, a.problems, problem.ProblemId, problem.ProblemVersion)
+	if err != nil {
+		return 0, err
+	}
+	return a.executor.Grade(ctx, last.Language, last.Code, executor.ExecuteOptions{
+		ProblemID:      int(problem.ProblemId),
+		ProblemVersion: int(problem.ProblemVersion),
+		Harness:        last.Harness,
+	}, weights)
+}
@@ -0,0 +1,26 @@
+// Package testtimer enforces the time limit of coding tests and submits
+// tests on the candidate's behalf when their time runs out.
+package testtimer
+
+import (
+	"time"
+
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+)
+
+// Deadline returns when test must be submitted: its duration after it was
+// started, but no later than the expiry of its link. ok is false when the
+// test has neither.
+func Deadline(test *codingtestspb.CodingTest) (deadline time.Time, ok bool) {
+	if test.StartedAt != nil && test.TestDurationMinutes > 0 {
+		deadline = test.StartedAt.AsTime().Add(time.Duration(test.TestDurationMinutes) * time.Minute)
+		ok = true
+	}
+	if test.ExpiresAt != nil {
+		if expiresAt := test.ExpiresAt.AsTime(); !ok || expiresAt.Before(deadline) {
+			deadline = expiresAt
+			ok = true
+		}
+	}
+	return deadline, ok
+}
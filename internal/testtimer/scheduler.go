@@ -0,0 +1,159 @@
+package testtimer
+
+import (
+	"sync"
+	"time"
+
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// retryDelay is how long a failed submission waits before its first
+	// retry. Each further retry waits twice as long as the one before.
+	retryDelay = 30 * time.Second
+	// maxSubmitAttempts bounds how often a test is tried. A test that is
+	// still not submitted after that is scheduled again the next time one of
+	// its routes sees it.
+	maxSubmitAttempts = 5
+)
+
+// Snapshot is the code a candidate last worked on. ProblemID is the problem
+// of a multi-problem assessment it was written for, and zero otherwise.
+// SavedAt is when the code was saved, as a draft or by Remember.
+type Snapshot struct {
+	ProblemID int32
+	Language  string
+	Code      string
+	Harness   bool
+	SavedAt   time.Time
+}
+
+// SubmitFunc submits a test whose time ran out. last is nil when the
+// candidate never ran any code. An error means the test could not be
+// submitted yet, e.g. because its code could not be graded, and that the
+// submission should be tried again.
+type SubmitFunc func(testID string, last *Snapshot) error
+
+// Scheduler calls a SubmitFunc for every scheduled test once its deadline
+// and the submission grace period have passed, so that a candidate's own
+// last-second submission is not raced. Failed submissions are retried with
+// backoff. Tests are tracked in memory, so a test started before a restart
+// is only scheduled again when one of its routes next sees it. A nil
+// Scheduler ignores every call, which disables auto-submission.
+type Scheduler struct {
+	mu     sync.Mutex
+	tests  map[string]*entry
+	submit SubmitFunc
+	grace  time.Duration
+}
+
+type entry struct {
+	deadline time.Time
+	timer    *time.Timer
+	last     *Snapshot
+	attempt  int
+}
+
+// NewScheduler returns a scheduler that submits tests grace after their
+// deadline, when manual submissions are no longer accepted.
+func NewScheduler(submit SubmitFunc, grace time.Duration) *Scheduler {
+	return &Scheduler{
+		tests:  make(map[string]*entry),
+		submit: submit,
+		grace:  grace,
+	}
+}
+
+// Schedule submits testID grace after deadline unless it is cancelled first.
+// Scheduling a test again with another deadline replaces the first one.
+func (s *Scheduler) Schedule(testID string, deadline time.Time) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &entry{deadline: deadline}
+	if old, ok := s.tests[testID]; ok {
+		if old.deadline.Equal(deadline) {
+			return
+		}
+		old.timer.Stop()
+		e.last = old.last
+	}
+	e.timer = time.AfterFunc(time.Until(deadline.Add(s.grace)), func() { s.fire(testID, e) })
+	s.tests[testID] = e
+}
+
+// Remember records the code a candidate is working on, to be submitted if
+// their time runs out and no newer draft was saved. It is ignored for tests
+// that are not scheduled.
+func (s *Scheduler) Remember(testID string, last Snapshot) {
+	if s == nil {
+		return
+	}
+	last.SavedAt = time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.tests[testID]; ok {
+		e.last = &last
+	}
+}
+
+// Cancel stops tracking a test, e.g. once the candidate submitted it.
+func (s *Scheduler) Cancel(testID string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.tests[testID]; ok {
+		e.timer.Stop()
+		delete(s.tests, testID)
+	}
+}
+
+func (s *Scheduler) fire(testID string, e *entry) {
+	s.mu.Lock()
+	if s.tests[testID] != e {
+		// Rescheduled or cancelled after the timer went off.
+		s.mu.Unlock()
+		return
+	}
+	delete(s.tests, testID)
+	last := e.last
+	s.mu.Unlock()
+
+	if err := s.submit(testID, last); err != nil {
+		s.retry(testID, e, err)
+	}
+}
+
+// retry schedules another attempt at a failed submission, unless the test
+// was scheduled again or the attempts are used up.
+func (s *Scheduler) retry(testID string, failed *entry, err error) {
+	log := logger.Get().With(
+		zap.String("test_id", testID),
+		zap.Int("attempt", failed.attempt+1),
+		zap.Error(err),
+	)
+	if failed.attempt+1 >= maxSubmitAttempts {
+		log.Error("giving up auto-submission")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tests[testID]; ok {
+		return
+	}
+
+	delay := retryDelay << failed.attempt
+	log.Warn("auto-submission failed, retrying", zap.Duration("delay", delay))
+	e := &entry{deadline: failed.deadline, last: failed.last, attempt: failed.attempt + 1}
+	e.timer = time.AfterFunc(delay, func() { s.fire(testID, e) })
+	s.tests[testID] = e
+}
@@ -0,0 +1,251 @@
+package testtimer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	codingtestspb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/coding_tests/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDeadline(t *testing.T) {
+	started := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		test   *codingtestspb.CodingTest
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name: "not started and no expiry",
+			test: &codingtestspb.CodingTest{TestDurationMinutes: 60},
+		},
+		{
+			name: "started without a duration",
+			test: &codingtestspb.CodingTest{StartedAt: timestamppb.New(started)},
+		},
+		{
+			name:   "started",
+			test:   &codingtestspb.CodingTest{StartedAt: timestamppb.New(started), TestDurationMinutes: 90},
+			want:   started.Add(90 * time.Minute),
+			wantOK: true,
+		},
+		{
+			name:   "not started, link expires",
+			test:   &codingtestspb.CodingTest{ExpiresAt: timestamppb.New(started), TestDurationMinutes: 90},
+			want:   started,
+			wantOK: true,
+		},
+		{
+			name: "link expires after the duration",
+			test: &codingtestspb.CodingTest{
+				StartedAt:           timestamppb.New(started),
+				ExpiresAt:           timestamppb.New(started.Add(24 * time.Hour)),
+				TestDurationMinutes: 90,
+			},
+			want:   started.Add(90 * time.Minute),
+			wantOK: true,
+		},
+		{
+			name: "link expires before the duration",
+			test: &codingtestspb.CodingTest{
+				StartedAt:           timestamppb.New(started),
+				ExpiresAt:           timestamppb.New(started.Add(30 * time.Minute)),
+				TestDurationMinutes: 90,
+			},
+			want:   started.Add(30 * time.Minute),
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Deadline(tt.test)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("Deadline() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// submission is a call of the SubmitFunc under test.
+type submission struct {
+	testID string
+	last   *Snapshot
+}
+
+func TestScheduler(t *testing.T) {
+	const grace = 10 * time.Millisecond
+
+	tests := []struct {
+		name string
+		// run drives the scheduler; the deadline is a little in the future.
+		run      func(s *Scheduler, deadline time.Time)
+		want     []string
+		wantCode string
+	}{
+		{
+			name: "submits after the deadline",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Schedule("a", deadline)
+			},
+			want: []string{"a"},
+		},
+		{
+			name: "submits the remembered code",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Schedule("a", deadline)
+				s.Remember("a", Snapshot{Code: "first"})
+				s.Remember("a", Snapshot{Code: "second"})
+			},
+			want:     []string{"a"},
+			wantCode: "second",
+		},
+		{
+			name: "ignores code of unscheduled tests",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Remember("a", Snapshot{Code: "early"})
+				s.Schedule("a", deadline)
+			},
+			want: []string{"a"},
+		},
+		{
+			name: "cancelled tests are not submitted",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Schedule("a", deadline)
+				s.Schedule("b", deadline)
+				s.Cancel("a")
+			},
+			want: []string{"b"},
+		},
+		{
+			name: "scheduling again keeps the code",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Schedule("a", deadline.Add(time.Hour))
+				s.Remember("a", Snapshot{Code: "kept"})
+				s.Schedule("a", deadline)
+			},
+			want:     []string{"a"},
+			wantCode: "kept",
+		},
+		{
+			name: "scheduling the same deadline twice submits once",
+			run: func(s *Scheduler, deadline time.Time) {
+				s.Schedule("a", deadline)
+				s.Schedule("a", deadline)
+			},
+			want: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submitted := make(chan submission, 8)
+			s := NewScheduler(func(testID string, last *Snapshot) error {
+				submitted <- submission{testID: testID, last: last}
+				return nil
+			}, grace)
+
+			tt.run(s, time.Now().Add(10*time.Millisecond))
+
+			var got []submission
+			timeout := time.After(200 * time.Millisecond)
+		collect:
+			for {
+				select {
+				case sub := <-submitted:
+					got = append(got, sub)
+				case <-timeout:
+					break collect
+				}
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d submissions, want %v", len(got), tt.want)
+			}
+			for i, sub := range got {
+				if sub.testID != tt.want[i] {
+					t.Errorf("submission %d is of %q, want %q", i, sub.testID, tt.want[i])
+				}
+			}
+			code := ""
+			if got[0].last != nil {
+				code = got[0].last.Code
+				if got[0].last.SavedAt.IsZero() {
+					t.Error("remembered code has no SavedAt")
+				}
+			}
+			if code != tt.wantCode {
+				t.Errorf("submitted code %q, want %q", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestSchedulerRetry(t *testing.T) {
+	tests := []struct {
+		name        string
+		attempt     int
+		err         error
+		wantRetry   bool
+		wantAttempt int
+	}{
+		{name: "success", err: nil},
+		{name: "first failure", err: errors.New("executor unavailable"), wantRetry: true, wantAttempt: 1},
+		{name: "later failure", attempt: 2, err: errors.New("executor unavailable"), wantRetry: true, wantAttempt: 3},
+		{name: "last attempt", attempt: maxSubmitAttempts - 1, err: errors.New("executor unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScheduler(func(string, *Snapshot) error { return tt.err }, 0)
+			last := &Snapshot{Code: "code"}
+			e := &entry{deadline: time.Now(), last: last, attempt: tt.attempt}
+			e.timer = time.NewTimer(time.Hour)
+			s.tests["a"] = e
+
+			s.fire("a", e)
+
+			s.mu.Lock()
+			retry, ok := s.tests["a"]
+			s.mu.Unlock()
+			if ok != tt.wantRetry {
+				t.Fatalf("retry scheduled = %v, want %v", ok, tt.wantRetry)
+			}
+			if !ok {
+				return
+			}
+			defer s.Cancel("a")
+			if retry.attempt != tt.wantAttempt || retry.last != last {
+				t.Errorf("retry is attempt %d with %v, want attempt %d with the failed code", retry.attempt, retry.last, tt.wantAttempt)
+			}
+		})
+	}
+}
+
+func TestSchedulerRetryYieldsToSchedule(t *testing.T) {
+	s := NewScheduler(func(string, *Snapshot) error { return nil }, 0)
+	failed := &entry{deadline: time.Now()}
+
+	// The test was scheduled again while the failed submission ran.
+	rescheduled := &entry{deadline: time.Now().Add(time.Hour), timer: time.NewTimer(time.Hour)}
+	s.tests["a"] = rescheduled
+	defer s.Cancel("a")
+
+	s.retry("a", failed, errors.New("executor unavailable"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tests["a"] != rescheduled {
+		t.Error("retry replaced a test that was scheduled again")
+	}
+}
+
+func TestNilScheduler(t *testing.T) {
+	var s *Scheduler
+	s.Schedule("a", time.Now())
+	s.Remember("a", Snapshot{Code: "code"})
+	s.Cancel("a")
+}
@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go-code-runner-microservice/api-gateway/internal/logger"
+	"go.uber.org/zap"
+)
+
+const maxBackoff = 5 * time.Minute
+
+var errPrivateAddress = errors.New("webhook address is in a private network")
+
+type Config struct {
+	// MaxAttempts is the number of requests made before a delivery fails.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles after
+	// every failed attempt.
+	InitialBackoff time.Duration
+	// Timeout bounds each request.
+	Timeout time.Duration
+	// AllowPrivateNetworks permits loopback and private addresses, e.g. for
+	// a local stub receiver.
+	AllowPrivateNetworks bool
+}
+
+// Dispatcher sends deliveries in the background and records them in a Log.
+// Deliveries are kept in queue until they finish, so that Resume can pick
+// them up after a restart of this replica.
+type Dispatcher struct {
+	client *http.Client
+	log    *Log
+	queue  *Queue
+	cfg    Config
+}
+
+// SecretFunc returns the secret to sign a delivery with when it is resumed
+// after a restart, or nil if it can no longer be sent, e.g. because its
+// subscription was deleted.
+type SecretFunc func(ctx context.Context, d Delivery) ([]byte, error)
+
+func NewDispatcher(cfg Config, log *Log, queue *Queue) *Dispatcher {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = rejectPrivate
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &Dispatcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+			// A redirect could lead anywhere, including past the
+			// private network check of the original host.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		log:   log,
+		queue: queue,
+		cfg:   cfg,
+	}
+}
+
+// Log returns the log deliveries are recorded in.
+func (d *Dispatcher) Log() *Log {
+	return d.log
+}
+
+// envelope is the JSON body of every delivery.
+type envelope struct {
+	ID        string    `json:"id"`
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// Message is an event for one URL. The delivery log records the IDs so that
+// deliveries can be looked up by subscription, job or test.
+type Message struct {
+	CompanyID int
+	WebhookID int
+	URL       string
+	Event     string
+	JobID     string
+	TestID    string
+	Data      any
+}
+
+// Send delivers m, signing it with secret. It returns the queued delivery
+// immediately; progress is recorded in the dispatcher's log under its ID.
+func (d *Dispatcher) Send(ctx context.Context, m Message, secret []byte) (Delivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	now := time.Now().UTC()
+	body, err := json.Marshal(envelope{ID: id, Event: m.Event, CreatedAt: now, Data: m.Data})
+	if err != nil {
+		return Delivery{}, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	return d.start(ctx, &Delivery{
+		ID:        id,
+		CompanyID: m.CompanyID,
+		WebhookID: m.WebhookID,
+		URL:       m.URL,
+		Event:     m.Event,
+		JobID:     m.JobID,
+		TestID:    m.TestID,
+		Status:    StatusPending,
+		CreatedAt: now,
+		body:      body,
+	}, secret)
+}
+
+// Redeliver sends orig, as returned by Log.Get, again under a new delivery
+// ID. The body is unchanged, so receivers can still deduplicate on its id.
+func (d *Dispatcher) Redeliver(ctx context.Context, orig Delivery, secret []byte) (Delivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	return d.start(ctx, &Delivery{
+		ID:           id,
+		CompanyID:    orig.CompanyID,
+		WebhookID:    orig.WebhookID,
+		URL:          orig.URL,
+		Event:        orig.Event,
+		JobID:        orig.JobID,
+		TestID:       orig.TestID,
+		RedeliveryOf: orig.ID,
+		Status:       StatusPending,
+		CreatedAt:    time.Now().UTC(),
+		body:         orig.body,
+	}, secret)
+}
+
+// start queues a new delivery, records it in the log and sends it in the
+// background.
+func (d *Dispatcher) start(ctx context.Context, delivery *Delivery, secret []byte) (Delivery, error) {
+	if err := d.queue.add(delivery); err != nil {
+		return Delivery{}, err
+	}
+	if err := d.log.save(ctx, delivery); err != nil {
+		d.dequeue(delivery.ID)
+		return Delivery{}, err
+	}
+
+	queued := *delivery
+	queued.body = nil
+	go d.deliver(delivery, secret)
+	return queued, nil
+}
+
+// Resume restarts the deliveries that were still pending in the queue when
+// the gateway stopped. Their attempts so far are kept in the log; receivers
+// can deduplicate on the delivery ID, which is unchanged.
+func (d *Dispatcher) Resume(ctx context.Context, secret SecretFunc) {
+	for _, pending := range d.queue.Pending() {
+		delivery := pending
+		if logged, ok, err := d.log.Get(ctx, delivery.CompanyID, delivery.ID); err == nil && ok {
+			delivery.Attempts = logged.Attempts
+		}
+
+		s, err := secret(ctx, delivery)
+		if err != nil || s == nil {
+			a := Attempt{At: time.Now().UTC(), Error: "delivery could not be resumed"}
+			if err != nil {
+				a.Error += ": " + err.Error()
+			}
+			d.finish(&delivery, a, StatusFailed)
+			continue
+		}
+		go d.deliver(&delivery, s)
+	}
+}
+
+// record adds an attempt to a delivery and saves it to the log.
+func (d *Dispatcher) record(delivery *Delivery, a Attempt, status string) {
+	delivery.Attempts = append(delivery.Attempts, a)
+	delivery.Status = status
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.Timeout)
+	defer cancel()
+	if err := d.log.save(ctx, delivery); err != nil {
+		logger.Get().Error("failed to record webhook delivery",
+			zap.String("delivery_id", delivery.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// finish records the last attempt of a delivery and drops it from the
+// queue.
+func (d *Dispatcher) finish(delivery *Delivery, a Attempt, status string) {
+	d.record(delivery, a, status)
+	d.dequeue(delivery.ID)
+}
+
+func (d *Dispatcher) dequeue(id string) {
+	if err := d.queue.finish(id); err != nil {
+		logger.Get().Error("failed to update webhook queue",
+			zap.String("delivery_id", id),
+			zap.Error(err),
+		)
+	}
+}
+
+// deliver sends a delivery until it succeeds or runs out of attempts. It
+// owns delivery from then on.
+func (d *Dispatcher) deliver(delivery *Delivery, secret []byte) {
+	backoff := d.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		a := d.attempt(delivery.ID, delivery.URL, secret, delivery.Event, delivery.body)
+		if a.Error == "" {
+			d.finish(delivery, a, StatusDelivered)
+			return
+		}
+		if attempt >= d.cfg.MaxAttempts {
+			d.finish(delivery, a, StatusFailed)
+			logger.Get().Warn("webhook delivery failed",
+				zap.String("delivery_id", delivery.ID),
+				zap.String("event", delivery.Event),
+				zap.Int("attempts", attempt),
+				zap.String("error", a.Error),
+			)
+			return
+		}
+		d.record(delivery, a, StatusPending)
+
+		time.Sleep(backoff)
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func (d *Dispatcher) attempt(id, url string, secret []byte, event string, body []byte) Attempt {
+	a := Attempt{At: time.Now().UTC()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		a.Error = err.Error()
+		return a
+	}
+	timestamp := strconv.FormatInt(a.At.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "code-runner-webhooks")
+	req.Header.Set(DeliveryHeader, id)
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	a.Duration = time.Since(a.At)
+	if err != nil {
+		a.Error = err.Error()
+		return a
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	a.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		a.Error = "unexpected status " + resp.Status
+	}
+	return a
+}
+
+func newDeliveryID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// rejectPrivate is a net.Dialer Control hook that refuses connections to
+// loopback, private and link-local addresses after DNS resolution.
+func rejectPrivate(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return errPrivateAddress
+	}
+	return nil
+}
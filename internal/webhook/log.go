@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	companyauthpb "go-code-runner-microservice/api-gateway/go-code-runner-microservice/proto/company_auth/v1"
+	"go-code-runner-microservice/api-gateway/internal/service/grpc/company_auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Events
+const (
+	// EventJobFinished is sent when an execution job reaches a terminal
+	// status.
+	EventJobFinished = "job.finished"
+
+	// Coding test lifecycle events, sent to company subscriptions.
+	EventTestStarted   = "test.started"
+	EventTestSubmitted = "test.submitted"
+	EventTestExpired   = "test.expired"
+	EventTestGraded    = "test.graded"
+
+	// EventPing is sent on request to check a subscription's endpoint.
+	EventPing = "ping"
+)
+
+// Delivery states
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Delivery is one event sent to one URL, with every attempt made so far.
+type Delivery struct {
+	ID        string
+	CompanyID int
+	// WebhookID is the subscription the delivery was sent for; zero for job
+	// callbacks.
+	WebhookID int
+	URL       string
+	Event     string
+	JobID     string
+	TestID    string
+	// RedeliveryOf is the ID of the delivery this one repeats, if any.
+	RedeliveryOf string
+	Status       string
+	CreatedAt    time.Time
+	Attempts     []Attempt
+
+	// body is kept so that the delivery can be sent again unchanged. Lists
+	// leave it out.
+	body []byte
+}
+
+// Attempt is a single HTTP request of a delivery. StatusCode is zero when no
+// response was received.
+type Attempt struct {
+	At         time.Time
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// Log is the delivery log. It is kept by the company auth service, so that
+// every gateway replica sees the deliveries of all of them.
+type Log struct {
+	client *company_auth.Client
+}
+
+func NewLog(client *company_auth.Client) *Log {
+	return &Log{client: client}
+}
+
+// save creates or replaces d in the log.
+func (l *Log) save(ctx context.Context, d *Delivery) error {
+	resp, err := l.client.SaveWebhookDelivery(ctx, toProto(d))
+	if err != nil {
+		return fmt.Errorf("save webhook delivery: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("save webhook delivery: %s", resp.GetError())
+	}
+	return nil
+}
+
+// Get returns the company's delivery with the given ID, including its body
+// so that it can be sent again.
+func (l *Log) Get(ctx context.Context, companyID int, id string) (Delivery, bool, error) {
+	resp, err := l.client.GetWebhookDelivery(ctx, id, int32(companyID))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return Delivery{}, false, nil
+		}
+		return Delivery{}, false, err
+	}
+	if !resp.Success || resp.Delivery == nil {
+		return Delivery{}, false, nil
+	}
+	return fromProto(resp.Delivery), true, nil
+}
+
+// Filter selects the deliveries of one company. Empty fields match any
+// delivery.
+type Filter struct {
+	CompanyID int
+	WebhookID int
+	JobID     string
+	TestID    string
+	Status    string
+}
+
+// List returns the deliveries matching f, newest first.
+func (l *Log) List(ctx context.Context, f Filter) ([]Delivery, error) {
+	resp, err := l.client.ListWebhookDeliveries(ctx, &companyauthpb.ListWebhookDeliveriesRequest{
+		CompanyId: int32(f.CompanyID),
+		WebhookId: int32(f.WebhookID),
+		JobId:     f.JobID,
+		TestId:    f.TestID,
+		Status:    f.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.GetError())
+	}
+
+	out := make([]Delivery, len(resp.Deliveries))
+	for i, d := range resp.Deliveries {
+		out[i] = fromProto(d)
+	}
+	return out, nil
+}
+
+func toProto(d *Delivery) *companyauthpb.WebhookDelivery {
+	out := &companyauthpb.WebhookDelivery{
+		Id:           d.ID,
+		CompanyId:    int32(d.CompanyID),
+		WebhookId:    int32(d.WebhookID),
+		Url:          d.URL,
+		Event:        d.Event,
+		JobId:        d.JobID,
+		TestId:       d.TestID,
+		RedeliveryOf: d.RedeliveryOf,
+		Status:       d.Status,
+		CreatedAt:    timestamppb.New(d.CreatedAt),
+		Attempts:     make([]*companyauthpb.WebhookDeliveryAttempt, len(d.Attempts)),
+		Body:         d.body,
+	}
+	for i, a := range d.Attempts {
+		out.Attempts[i] = &companyauthpb.WebhookDeliveryAttempt{
+			At:         timestamppb.New(a.At),
+			StatusCode: int32(a.StatusCode),
+			Error:      a.Error,
+			DurationMs: a.Duration.Milliseconds(),
+		}
+	}
+	return out
+}
+
+func fromProto(d *companyauthpb.WebhookDelivery) Delivery {
+	out := Delivery{
+		ID:           d.Id,
+		CompanyID:    int(d.CompanyId),
+		WebhookID:    int(d.WebhookId),
+		URL:          d.Url,
+		Event:        d.Event,
+		JobID:        d.JobId,
+		TestID:       d.TestId,
+		RedeliveryOf: d.RedeliveryOf,
+		Status:       d.Status,
+		CreatedAt:    d.CreatedAt.AsTime(),
+		Attempts:     make([]Attempt, len(d.Attempts)),
+		body:         d.Body,
+	}
+	for i, a := range d.Attempts {
+		out.Attempts[i] = Attempt{
+			At:         a.At.AsTime(),
+			StatusCode: int(a.StatusCode),
+			Error:      a.Error,
+			Duration:   time.Duration(a.DurationMs) * time.Millisecond,
+		}
+	}
+	return out
+}
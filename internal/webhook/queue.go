@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// compactAfter is the number of finished records the queue file may hold
+// before it is rewritten with only the pending deliveries.
+const compactAfter = 1000
+
+// Queue persists deliveries until they are delivered or fail for good, so
+// that they are resumed after a restart. It is an append-only file of JSON
+// lines, one when a delivery is queued and one when it finishes, rewritten
+// once finished records pile up. Secrets are not stored; they are looked up
+// again on resume. A nil *Queue keeps nothing.
+type Queue struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	pending  map[string]queuedDelivery
+	finished int
+}
+
+// queueRecord is one line of the queue file. Delivery is set for queued
+// records only.
+type queueRecord struct {
+	ID       string          `json:"id"`
+	Delivery *queuedDelivery `json:"delivery,omitempty"`
+}
+
+type queuedDelivery struct {
+	ID           string    `json:"id"`
+	CompanyID    int       `json:"company_id"`
+	WebhookID    int       `json:"webhook_id,omitempty"`
+	URL          string    `json:"url"`
+	Event        string    `json:"event"`
+	JobID        string    `json:"job_id,omitempty"`
+	TestID       string    `json:"test_id,omitempty"`
+	RedeliveryOf string    `json:"redelivery_of,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	Body         []byte    `json:"body"`
+}
+
+// OpenQueue opens (or creates) the queue file at path and loads the
+// deliveries that were still pending when it was last written.
+func OpenQueue(path string) (*Queue, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("create webhook queue directory: %w", err)
+	}
+
+	q := &Queue{
+		path:    path,
+		pending: make(map[string]queuedDelivery),
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	if err := q.compact(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *Queue) load() error {
+	f, err := os.Open(q.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("open webhook queue %s: %w", q.path, err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			var rec queueRecord
+			// A torn last line from a crash is skipped.
+			if json.Unmarshal(line, &rec) == nil {
+				if rec.Delivery != nil {
+					q.pending[rec.ID] = *rec.Delivery
+				} else {
+					delete(q.pending, rec.ID)
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read webhook queue %s: %w", q.path, err)
+		}
+	}
+}
+
+// compact rewrites the file with only the pending deliveries. Callers must
+// hold q.mu, unless the queue is not shared yet.
+func (q *Queue) compact() error {
+	tmp := q.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("create webhook queue %s: %w", tmp, err)
+	}
+	w := bufio.NewWriter(f)
+	for _, d := range q.pending {
+		line, err := json.Marshal(queueRecord{ID: d.ID, Delivery: &d})
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("marshal webhook delivery: %w", err)
+		}
+		w.Write(append(line, '\n'))
+	}
+	if err := w.Flush(); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write webhook queue %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, q.path); err != nil {
+		return fmt.Errorf("replace webhook queue %s: %w", q.path, err)
+	}
+
+	if q.file != nil {
+		q.file.Close()
+	}
+	q.file, err = os.OpenFile(q.path, os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("open webhook queue %s: %w", q.path, err)
+	}
+	q.finished = 0
+	return nil
+}
+
+// append writes rec and syncs the file. Callers must hold q.mu.
+func (q *Queue) append(rec queueRecord) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal webhook delivery: %w", err)
+	}
+	if _, err := q.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write webhook queue: %w", err)
+	}
+	if err := q.file.Sync(); err != nil {
+		return fmt.Errorf("sync webhook queue: %w", err)
+	}
+	return nil
+}
+
+// add persists a delivery that is about to be sent.
+func (q *Queue) add(d *Delivery) error {
+	if q == nil {
+		return nil
+	}
+	qd := queuedDelivery{
+		ID:           d.ID,
+		CompanyID:    d.CompanyID,
+		WebhookID:    d.WebhookID,
+		URL:          d.URL,
+		Event:        d.Event,
+		JobID:        d.JobID,
+		TestID:       d.TestID,
+		RedeliveryOf: d.RedeliveryOf,
+		CreatedAt:    d.CreatedAt,
+		Body:         d.body,
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.append(queueRecord{ID: d.ID, Delivery: &qd}); err != nil {
+		return err
+	}
+	q.pending[d.ID] = qd
+	return nil
+}
+
+// finish drops a delivery that was delivered or failed every attempt.
+func (q *Queue) finish(id string) error {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.pending[id]; !ok {
+		return nil
+	}
+	delete(q.pending, id)
+	if err := q.append(queueRecord{ID: id}); err != nil {
+		return err
+	}
+	q.finished++
+	if q.finished >= compactAfter && q.finished > len(q.pending) {
+		return q.compact()
+	}
+	return nil
+}
+
+// Pending returns the deliveries that have not finished, oldest first.
+func (q *Queue) Pending() []Delivery {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	out := make([]Delivery, 0, len(q.pending))
+	for _, d := range q.pending {
+		out = append(out, Delivery{
+			ID:           d.ID,
+			CompanyID:    d.CompanyID,
+			WebhookID:    d.WebhookID,
+			URL:          d.URL,
+			Event:        d.Event,
+			JobID:        d.JobID,
+			TestID:       d.TestID,
+			RedeliveryOf: d.RedeliveryOf,
+			Status:       StatusPending,
+			CreatedAt:    d.CreatedAt,
+			body:         d.Body,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+func (q *Queue) Close() error {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.file.Close()
+}
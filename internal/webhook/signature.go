@@ -0,0 +1,51 @@
+// Package webhook delivers signed event notifications to HTTP endpoints,
+// retrying failed deliveries and keeping a log of every attempt.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// Headers sent with every delivery. The signature covers the timestamp and
+// the body, joined by a dot, so that a captured request cannot be replayed
+// with a different timestamp.
+const (
+	DeliveryHeader  = "X-Webhook-Delivery"
+	EventHeader     = "X-Webhook-Event"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header value for a delivery body sent at
+// timestamp, in Unix seconds.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CompanySecret derives the secret a company's job callbacks are signed with
+// from the deployment's signing key. Each company gets its own secret, so
+// one company cannot sign callbacks for another.
+func CompanySecret(key []byte, companyID int) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("job-callbacks:"))
+	mac.Write([]byte(strconv.Itoa(companyID)))
+	return []byte(hex.EncodeToString(mac.Sum(nil)))
+}
+
+// Verify reports whether signature matches the delivery body and timestamp.
+func Verify(secret []byte, timestamp string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
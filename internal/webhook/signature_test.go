@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+		want      string
+	}{
+		{
+			name:      "json body",
+			secret:    "secret",
+			timestamp: "1700000000",
+			body:      `{"event":"job.completed"}`,
+			want:      "sha256=e33f34cc0b46f4e752fe75a10d7177366fd795c052ed09dfa63608265c13be69",
+		},
+		{
+			name:      "empty body",
+			secret:    "secret",
+			timestamp: "1700000000",
+			body:      "",
+			want:      "sha256=4bc5f74d868b97888288889c5d9d65df02526f94c1592a79fdf4fe8b26e311e5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign([]byte(tt.secret), tt.timestamp, []byte(tt.body)); got != tt.want {
+				t.Errorf("Sign() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"event":"job.completed"}`)
+	signature := Sign(secret, "1700000000", body)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		timestamp string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, timestamp: "1700000000", body: body, signature: signature, want: true},
+		{name: "other secret", secret: []byte("other"), timestamp: "1700000000", body: body, signature: signature},
+		{name: "other timestamp", secret: secret, timestamp: "1700000001", body: body, signature: signature},
+		{name: "other body", secret: secret, timestamp: "1700000000", body: []byte(`{"event":"job.failed"}`), signature: signature},
+		{name: "missing prefix", secret: secret, timestamp: "1700000000", body: body, signature: signature[len(signaturePrefix):]},
+		{name: "empty signature", secret: secret, timestamp: "1700000000", body: body, signature: ""},
+		{
+			// The dot keeps the timestamp and body apart.
+			name:      "digits moved from timestamp to body",
+			secret:    secret,
+			timestamp: "170000000",
+			body:      append([]byte("0"), body...),
+			signature: signature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompanySecret(t *testing.T) {
+	key := []byte("signing-key")
+
+	tests := []struct {
+		name  string
+		key   []byte
+		a, b  int
+		equal bool
+	}{
+		{name: "same company", key: key, a: 7, b: 7, equal: true},
+		{name: "other company", key: key, a: 7, b: 8},
+		{name: "prefix of another ID", key: key, a: 1, b: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := CompanySecret(tt.key, tt.a), CompanySecret(tt.key, tt.b)
+			if got := bytes.Equal(a, b); got != tt.equal {
+				t.Errorf("CompanySecret(%d) == CompanySecret(%d) is %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+		})
+	}
+
+	if bytes.Equal(CompanySecret(key, 7), CompanySecret([]byte("other-key"), 7)) {
+		t.Error("CompanySecret() does not depend on the key")
+	}
+}